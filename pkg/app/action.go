@@ -125,19 +125,20 @@ var (
 	editResourceAction = &guilib.Action{
 		Name:    editResourceActionName,
 		Keys:    keyMap[editResourceActionName],
-		Handler: editResourceHandler,
+		Handler: permissionRequired(editPermission, editResourceHandler),
 		Mod:     gocui.ModNone,
 	}
 
 	rolloutRestartAction = &guilib.Action{
 		Keys:    keyMap[rolloutRestartActionName],
 		Name:    rolloutRestartActionName,
-		Handler: rolloutRestartHandler,
+		Handler: permissionRequired(rolloutRestartPermission, rolloutRestartHandler),
 		Mod:     gocui.ModNone,
 	}
 
 	editResourceMoreAction = &moreAction{
 		NeedSelectResource: true,
+		Permission:         editPermission,
 		Action:             *editResourceAction,
 	}
 
@@ -158,7 +159,7 @@ var (
 	containerExecCommandAction = &guilib.Action{
 		Keys:    keyMap[containerExecCommandActionName],
 		Name:    containerExecCommandActionName,
-		Handler: containerExecCommandHandler,
+		Handler: permissionRequired(execPermission, containerExecCommandHandler),
 		Mod:     gocui.ModNone,
 	}
 
@@ -169,6 +170,48 @@ var (
 		Mod:     gocui.ModNone,
 	}
 
+	includeLogsAction = &guilib.Action{
+		Keys:    keyMap[includeLogsActionName],
+		Name:    includeLogsActionName,
+		Handler: logNavigationRequired(includeLogsHandler),
+		Mod:     gocui.ModNone,
+	}
+
+	excludeLogsAction = &guilib.Action{
+		Keys:    keyMap[excludeLogsActionName],
+		Name:    excludeLogsActionName,
+		Handler: logNavigationRequired(excludeLogsHandler),
+		Mod:     gocui.ModNone,
+	}
+
+	toggleLogFilterAction = &guilib.Action{
+		Keys:    keyMap[toggleLogFilterActionName],
+		Name:    toggleLogFilterActionName,
+		Handler: logNavigationRequired(toggleLogFilterHandler),
+		Mod:     gocui.ModNone,
+	}
+
+	toggleRawLogsAction = &guilib.Action{
+		Keys:    keyMap[toggleRawLogsActionName],
+		Name:    toggleRawLogsActionName,
+		Handler: logNavigationRequired(toggleRawLogsHandler),
+		Mod:     gocui.ModNone,
+	}
+
+	selectLogRangeAction = &guilib.Action{
+		Keys:    keyMap[selectLogRangeActionName],
+		Name:    selectLogRangeActionName,
+		Handler: logNavigationRequired(selectLogRangeHandler),
+		Mod:     gocui.ModNone,
+	}
+
+	saveToFileAction = &guilib.Action{
+		Keys:    keyMap[saveToFileActionName],
+		Name:    saveToFileActionName,
+		Handler: saveToFileHandler,
+		Mod:     gocui.ModNone,
+	}
+
 	tailLogsAction = &guilib.Action{
 		Keys:    keyMap[tailLogsActionName],
 		Name:    tailLogsActionName,
@@ -197,6 +240,13 @@ var (
 		Mod:     gocui.ModNone,
 	}
 
+	applyManifestAction = &guilib.Action{
+		Keys:    keyMap[applyManifestActionName],
+		Name:    applyManifestActionName,
+		Handler: applyManifestHandler,
+		Mod:     gocui.ModNone,
+	}
+
 	addCustomResourcePanelMoreAction = &moreAction{
 		NeedSelectResource: false,
 		Action:             *addCustomResourcePanelAction,
@@ -209,6 +259,7 @@ var (
 
 	containerExecCommandMoreAction = &moreAction{
 		NeedSelectResource: true,
+		Permission:         execPermission,
 		Action:             *containerExecCommandAction,
 	}
 
@@ -217,12 +268,170 @@ var (
 		Action:             *runPodAction,
 	}
 
+	selectCompareContextsAction = &guilib.Action{
+		Keys:    keyMap[selectCompareContextsActionName],
+		Name:    selectCompareContextsActionName,
+		Handler: selectCompareContextsHandler,
+		Mod:     gocui.ModNone,
+	}
+
+	persistContextAction = &guilib.Action{
+		Keys:    keyMap[persistContextActionName],
+		Name:    persistContextActionName,
+		Handler: persistContextHandler,
+		Mod:     gocui.ModNone,
+	}
+
+	persistContextMoreAction = &moreAction{
+		NeedSelectResource: false,
+		Action:             *persistContextAction,
+	}
+
 	changeContextMoreAction = &moreAction{
 		NeedSelectResource: false,
 		ShowAction:         nil,
 		Action:             *changeContext,
 	}
 
+	switchConfigYAMLModeAction = &guilib.Action{
+		Keys:    keyMap[switchConfigYAMLModeActionName],
+		Name:    switchConfigYAMLModeActionName,
+		Handler: switchConfigYAMLModeHandler,
+		Mod:     gocui.ModNone,
+	}
+
+	addHelmPanelAction = newAddOptionalPanelAction(helmViewName, addHelmPanelActionName)
+
+	deleteFunctionPanelAction = &guilib.Action{
+		Keys: keyMap[deleteFunctionPanelActionName],
+		Name: deleteFunctionPanelActionName,
+		Handler: func(gui *guilib.Gui, view *guilib.View) error {
+			return deleteOptionalPanel(gui, view.Name)
+		},
+		Mod: gocui.ModNone,
+	}
+
+	rollbackHelmReleaseAction = &guilib.Action{
+		Keys:    keyMap[rollbackHelmReleaseActionName],
+		Name:    rollbackHelmReleaseActionName,
+		Handler: rollbackHelmReleaseHandler,
+		Mod:     gocui.ModNone,
+	}
+
+	addCronJobPanelAction = newAddOptionalPanelAction(cronJobViewName, addCronJobPanelActionName)
+
+	triggerCronJobAction = &guilib.Action{
+		Keys:    keyMap[triggerCronJobActionName],
+		Name:    triggerCronJobActionName,
+		Handler: permissionRequired(createJobsPermission, triggerCronJobHandler),
+		Mod:     gocui.ModNone,
+	}
+
+	suspendCronJobAction = &guilib.Action{
+		Keys:    keyMap[suspendCronJobActionName],
+		Name:    suspendCronJobActionName,
+		Handler: suspendCronJobHandler,
+		Mod:     gocui.ModNone,
+	}
+
+	deleteFinishedJobsAction = &guilib.Action{
+		Keys:    keyMap[deleteFinishedJobsActionName],
+		Name:    deleteFinishedJobsActionName,
+		Handler: permissionRequired(deleteJobsPermission, deleteFinishedJobsHandler),
+		Mod:     gocui.ModNone,
+	}
+
+	viewJobPodLogsAction = &guilib.Action{
+		Keys:    keyMap[viewJobPodLogsActionName],
+		Name:    viewJobPodLogsActionName,
+		Handler: viewJobPodLogsHandler,
+		Mod:     gocui.ModNone,
+	}
+
+	addStoragePanelAction = newAddOptionalPanelAction(storageViewName, addStoragePanelActionName)
+
+	addIngressPanelAction = newAddOptionalPanelAction(ingressViewName, addIngressPanelActionName)
+
+	browseCRDsAction = &guilib.Action{
+		Keys:    keyMap[browseCRDsActionName],
+		Name:    browseCRDsActionName,
+		Handler: browseCustomResourceDefinitionsHandler,
+		Mod:     gocui.ModNone,
+	}
+
+	favoriteNamespaceAction = &guilib.Action{
+		Keys:    keyMap[favoriteNamespaceActionName],
+		Name:    favoriteNamespaceActionName,
+		Handler: favoriteNamespaceHandler,
+		Mod:     gocui.ModNone,
+	}
+
+	onlyFavoriteNamespacesAction = &guilib.Action{
+		Keys:    keyMap[onlyFavoriteNamespacesActionName],
+		Name:    onlyFavoriteNamespacesActionName,
+		Handler: onlyFavoriteNamespacesHandler,
+		Mod:     gocui.ModNone,
+	}
+
+	toggleAllNamespacesAction = &guilib.Action{
+		Keys:    keyMap[toggleAllNamespacesActionName],
+		Name:    toggleAllNamespacesActionName,
+		Handler: toggleAllNamespacesHandler,
+		Mod:     gocui.ModNone,
+	}
+
+	exploreAPIResourcesAction = &guilib.Action{
+		Keys:    keyMap[exploreAPIResourcesActionName],
+		Name:    exploreAPIResourcesActionName,
+		Handler: exploreAPIResourcesHandler,
+		Mod:     gocui.ModNone,
+	}
+
+	exploreAPIResourcesMoreAction = &moreAction{
+		NeedSelectResource: false,
+		Action:             *exploreAPIResourcesAction,
+	}
+
+	browseCRDsMoreAction = &moreAction{
+		NeedSelectResource: false,
+		Action:             *browseCRDsAction,
+	}
+
+	addIngressPanelMoreAction = &moreAction{
+		NeedSelectResource: false,
+		Action:             *addIngressPanelAction,
+	}
+
+	addStoragePanelMoreAction = &moreAction{
+		NeedSelectResource: false,
+		Action:             *addStoragePanelAction,
+	}
+
+	addCronJobPanelMoreAction = &moreAction{
+		NeedSelectResource: false,
+		Action:             *addCronJobPanelAction,
+	}
+
+	addHelmPanelMoreAction = &moreAction{
+		NeedSelectResource: false,
+		Action:             *addHelmPanelAction,
+	}
+
+	deleteFunctionPanelMoreAction = &moreAction{
+		NeedSelectResource: false,
+		Action:             *deleteFunctionPanelAction,
+	}
+
+	rollbackHelmReleaseMoreAction = &moreAction{
+		NeedSelectResource: true,
+		Action:             *rollbackHelmReleaseAction,
+	}
+
+	applyManifestMoreAction = &moreAction{
+		NeedSelectResource: false,
+		Action:             *applyManifestAction,
+	}
+
 	commonResourceMoreActions = []*moreAction{
 		addCustomResourcePanelMoreAction,
 		editResourceMoreAction,
@@ -232,10 +441,27 @@ var (
 		clusterInfoViewName: {
 			addCustomResourcePanelMoreAction,
 			changeContextMoreAction,
+			persistContextMoreAction,
+			applyManifestMoreAction,
+			addHelmPanelMoreAction,
+			addCronJobPanelMoreAction,
+			addStoragePanelMoreAction,
+			addIngressPanelMoreAction,
+			browseCRDsMoreAction,
+			exploreAPIResourcesMoreAction,
 		},
 		namespaceViewName: append(
 			commonResourceMoreActions,
 			copySelectedLineMoreAction,
+			applyManifestMoreAction,
+			&moreAction{
+				NeedSelectResource: true,
+				Action:             *favoriteNamespaceAction,
+			},
+			&moreAction{
+				NeedSelectResource: false,
+				Action:             *onlyFavoriteNamespacesAction,
+			},
 		),
 		serviceViewName: append(
 			commonResourceMoreActions,
@@ -246,8 +472,13 @@ var (
 			copySelectedLineMoreAction,
 			&moreAction{
 				NeedSelectResource: true,
+				Permission:         rolloutRestartPermission,
 				Action:             *newConfirmDialogAction(deploymentViewName, rolloutRestartAction),
 			},
+			&moreAction{
+				NeedSelectResource: false,
+				Action:             *selectCompareContextsAction,
+			},
 		),
 		podViewName: append(
 			commonResourceMoreActions,
@@ -298,11 +529,60 @@ var (
 				},
 				Action: *editResourceAction,
 			},
+			&moreAction{
+				NeedSelectResource: false,
+				ShowAction: func(gui *guilib.Gui, view *guilib.View) bool {
+					return activeNavigationOpt == navigationOptConfig
+				},
+				Action: *switchConfigYAMLModeAction,
+			},
+			&moreAction{
+				NeedSelectResource: false,
+				ShowAction: func(gui *guilib.Gui, view *guilib.View) bool {
+					return navigationPath(activeView.Name, activeNavigationOpt) == navigationPath(cronJobViewName, navigationOptJobs)
+				},
+				Action: *viewJobPodLogsAction,
+			},
+			&moreAction{
+				NeedSelectResource: false,
+				Action:             *saveToFileAction,
+			},
+			&moreAction{
+				NeedSelectResource: false,
+				ShowAction:         isLogNavigation,
+				Action:             *selectLogRangeAction,
+			},
+			&moreAction{
+				NeedSelectResource: false,
+				ShowAction:         isLogNavigation,
+				Action:             *includeLogsAction,
+			},
+			&moreAction{
+				NeedSelectResource: false,
+				ShowAction:         isLogNavigation,
+				Action:             *excludeLogsAction,
+			},
+			&moreAction{
+				NeedSelectResource: false,
+				ShowAction:         isLogNavigation,
+				Action:             *toggleLogFilterAction,
+			},
+			&moreAction{
+				NeedSelectResource: false,
+				ShowAction:         isLogNavigation,
+				Action:             *toggleRawLogsAction,
+			},
 		},
 	}
 
 	appActions = []*guilib.Action{
 		backToPreviousView,
+		{
+			Name:    switchNamespaceActionName,
+			Keys:    keyMap[switchNamespaceActionName],
+			Handler: switchNamespaceHandler,
+			Mod:     gocui.ModNone,
+		},
 		{
 			Name:    previousPageAction,
 			Keys:    keyMap[previousPageAction],
@@ -346,12 +626,16 @@ type (
 	moreAction struct {
 		NeedSelectResource bool
 		ShowAction         func(*guilib.Gui, *guilib.View) bool
+		Permission         *permission
 		guilib.Action
 	}
 )
 
 func switchNamespace(gui *guilib.Gui, selectedNamespaceLine string) {
 	kubecli.Cli.SetNamespace(selectedNamespaceLine)
+	if podMetricsCollector != nil {
+		podMetricsCollector.Watch(kubecli.Cli.CurrentContext(), kubecli.Cli.Namespace())
+	}
 	for _, viewName := range []string{serviceViewName, deploymentViewName, podViewName} {
 		view, err := gui.GetView(viewName)
 		if err != nil {
@@ -376,6 +660,20 @@ func switchNamespace(gui *guilib.Gui, selectedNamespaceLine string) {
 	gui.ReRenderViews(clusterInfoViewName, navigationViewName, detailViewName)
 }
 
+func isLogNavigation(*guilib.Gui, *guilib.View) bool {
+	return activeNavigationOpt == navigationOptLog || activeNavigationOpt == navigationOptPodsLog
+}
+
+// logNavigationRequired only run handler when Detail is showing logs.
+func logNavigationRequired(handler guilib.ViewHandler) guilib.ViewHandler {
+	return func(gui *guilib.Gui, view *guilib.View) error {
+		if !isLogNavigation(gui, view) {
+			return nil
+		}
+		return handler(gui, view)
+	}
+}
+
 func newMoreActions(moreActions []*moreAction) *guilib.Action {
 	return &guilib.Action{
 		Name: moreActionsName,
@@ -421,3 +719,15 @@ func newConfirmFilterInput(confirmHandler func(string) error) *guilib.Action {
 	}
 	return confirmFilterInput
 }
+
+// newAddOptionalPanelAction returns action which adds the optional function panel of view name.
+func newAddOptionalPanelAction(name, actionName string) *guilib.Action {
+	return &guilib.Action{
+		Keys: keyMap[actionName],
+		Name: actionName,
+		Handler: func(gui *guilib.Gui, _ *guilib.View) error {
+			return addOptionalPanel(gui, name)
+		},
+		Mod: gocui.ModNone,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/Matt-Gleich/release"
 	"github.com/TNK-Studio/lazykube/pkg/config"
 	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
 	"github.com/TNK-Studio/lazykube/pkg/log"
 	"github.com/TNK-Studio/lazykube/pkg/utils"
 	"github.com/gookit/color"
@@ -46,6 +47,11 @@ func NewApp() *App {
 		Option:      Option,
 	}
 
+	// Restore the last used namespace of current context.
+	if recents := contextNamespaces().Recents; len(recents) > 0 {
+		kubecli.Cli.SetNamespace(recents[0])
+	}
+
 	app.Gui = guilib.NewGui(
 		*config.Conf.GuiConfig,
 		app.ClusterInfo,
@@ -84,12 +90,18 @@ func (app *App) CheckRelease() (bool, string, error) {
 
 // Run run
 func (app *App) Run() {
+	podMetricsCollector = newMetricsCollector(config.Conf.UserConfig.GetMetricsConfig())
+	podMetricsCollector.Watch(kubecli.Cli.CurrentContext(), kubecli.Cli.Namespace())
+	podMetricsCollector.Start()
 	app.Gui.Run()
 }
 
 // Stop stop
 func (app *App) Stop() {
 	app.Gui.Close()
+	if podMetricsCollector != nil {
+		podMetricsCollector.Stop()
+	}
 	isOutdated, version, err := app.CheckRelease()
 	if err == nil && isOutdated {
 		fmt.Printf(
@@ -106,10 +118,13 @@ func (app *App) Stop() {
 // OnRender OnRender
 func (app *App) OnRender(gui *guilib.Gui) error {
 	if config.Conf.UserConfig.CustomResourcePanels != nil {
-		for _, resource := range config.Conf.UserConfig.CustomResourcePanels {
-			if err := addCustomResourcePanel(gui, resource); err != nil {
-				log.Logger.Warningf("app.OnRender - addCustomResourcePanel(gui, %s) error %s", resource, err)
-			}
+		// Copy it because panels of old plural names will be migrated.
+		resources := append([]string{}, config.Conf.UserConfig.CustomResourcePanels...)
+		addCustomResourcePanels(gui, resources)
+	}
+	for _, name := range config.Conf.UserConfig.OptionalPanels {
+		if err := addOptionalPanel(gui, name); err != nil {
+			log.Logger.Warningf("app.OnRender - addOptionalPanel(gui, '%s') error %s", name, err)
 		}
 	}
 	return nil
@@ -129,6 +144,7 @@ func (app *App) OnRenderOptions(gui *guilib.Gui) error {
 				"Tab":       "next panel",
 				"f":         "filter",
 				"m":         "more action",
+				"Ctrl+n":    "namespaces",
 			}),
 	)
 }
@@ -78,6 +78,30 @@ func showFilterDialog(
 	dataFunc func(inputted string) ([]string, error),
 	noResultMsg string,
 	showInputValueInFiltered bool,
+) error {
+	return addFilterDialog(gui, title, confirmHandler, dataFunc, noResultMsg, showInputValueInFiltered, false)
+}
+
+// showFuzzyFilterDialog show filter dialog which also lists fuzzy matches after substring matches.
+func showFuzzyFilterDialog(
+	gui *guilib.Gui,
+	title string,
+	confirmHandler func(confirmed string) error,
+	dataFunc func(inputted string) ([]string, error),
+	noResultMsg string,
+	showInputValueInFiltered bool,
+) error {
+	return addFilterDialog(gui, title, confirmHandler, dataFunc, noResultMsg, showInputValueInFiltered, true)
+}
+
+func addFilterDialog(
+	gui *guilib.Gui,
+	title string,
+	confirmHandler func(confirmed string) error,
+	dataFunc func(inputted string) ([]string, error),
+	noResultMsg string,
+	showInputValueInFiltered bool,
+	fuzzy bool,
 ) error {
 	var filterInput, filtered *guilib.View
 	// If views existed.
@@ -90,7 +114,7 @@ func showFilterDialog(
 		_ = gui.DeleteView(filteredViewName)
 	}
 
-	filterInput, filtered = newFilterDialog(title, confirmHandler, dataFunc, noResultMsg, showInputValueInFiltered)
+	filterInput, filtered = newFilterDialog(title, confirmHandler, dataFunc, noResultMsg, showInputValueInFiltered, fuzzy)
 	if err := gui.AddView(filterInput); err != nil {
 		return err
 	}
@@ -185,6 +209,7 @@ func newFilterDialog(
 	dataFunc func(inputted string) ([]string, error),
 	noResultMsg string,
 	showInputValueInFiltered bool,
+	fuzzy bool,
 ) (*guilib.View, *guilib.View) {
 	confirmAction := newConfirmFilterInput(confirmHandler)
 	filterInput := &guilib.View{
@@ -291,12 +316,19 @@ func newFilterDialog(
 			}
 
 			filtered := make([]string, 0)
+			fuzzyFiltered := make([]string, 0)
 			value = strings.TrimSpace(strings.ToLower(value))
 			for _, resource := range data {
 				if strings.Contains(strings.ToLower(resource), value) {
 					filtered = append(filtered, resource)
+					continue
+				}
+				if fuzzy && utils.FuzzyMatch(strings.ToLower(resource), value) {
+					fuzzyFiltered = append(fuzzyFiltered, resource)
 				}
 			}
+			// Exact matches first, then fuzzy matches.
+			filtered = append(filtered, fuzzyFiltered...)
 
 			if showInputValueInFiltered && value != "" && len(filtered) == 0 {
 				filtered = append([]string{value}, filtered...)
@@ -373,6 +405,15 @@ func newMoreActionDialog(title string, moreActions []*moreAction) *guilib.View {
 						continue
 					}
 				}
+				if moreAct.Permission != nil {
+					resourceView, err := getMoreActionTriggerView(view)
+					if err == nil {
+						if allowed, _ := hasPermission(gui, resourceView, moreAct.Permission); !allowed {
+							moreActionsDescription = append(moreActionsDescription, keyMapDescription(moreAct.Keys, color.Gray.Sprintf("%s (forbidden)", moreAct.Name)))
+							continue
+						}
+					}
+				}
 				moreActionsDescription = append(moreActionsDescription, keyMapDescription(moreAct.Keys, moreAct.Name))
 			}
 
@@ -1,10 +1,20 @@
 package app
 
 import (
+	"fmt"
 	"github.com/TNK-Studio/lazykube/pkg/utils"
+	"github.com/gookit/color"
+	"math"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
+var (
+	yamlKeyValueRegexp = regexp.MustCompile(`^(\s*(?:- )*)([^\s#"'-][^:#]*|"[^"]*"|'[^']*'):(\s+|$)(.*)$`)
+	yamlListItemRegexp = regexp.MustCompile(`^(\s*- )(.*)$`)
+)
+
 func formatSelectedNamespace(selected string) string {
 	return formatResourceName(selected, 0)
 }
@@ -39,3 +49,78 @@ func validateResourceName(resourceName string) bool {
 
 	return true
 }
+
+func colorfulDiff(diff string) string {
+	lines := strings.Split(diff, "\n")
+	for index, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "diff "):
+			lines[index] = color.Blue.Sprint(line)
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			lines[index] = color.Yellow.Sprint(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[index] = color.Cyan.Sprint(line)
+		case strings.HasPrefix(line, "+"):
+			lines[index] = color.Green.Sprint(line)
+		case strings.HasPrefix(line, "-"):
+			lines[index] = color.Red.Sprint(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func colorfulYAML(yaml string) string {
+	lines := strings.Split(yaml, "\n")
+	for index, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			lines[index] = color.Gray.Sprint(line)
+			continue
+		}
+
+		if matched := yamlKeyValueRegexp.FindStringSubmatch(line); matched != nil {
+			lines[index] = matched[1] + color.Cyan.Sprint(matched[2]) + ":" + matched[3] + colorfulYAMLValue(matched[4])
+			continue
+		}
+
+		if matched := yamlListItemRegexp.FindStringSubmatch(line); matched != nil {
+			lines[index] = matched[1] + colorfulYAMLValue(matched[2])
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func colorfulYAMLValue(value string) string {
+	switch {
+	case value == "", value == "|", value == ">", strings.HasPrefix(value, "|-"), strings.HasPrefix(value, ">-"):
+		return value
+	case value == "true", value == "false", value == "null", value == "~":
+		return color.Magenta.Sprint(value)
+	}
+
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return color.Yellow.Sprint(value)
+	}
+	return color.Green.Sprint(value)
+}
+
+// colorfulGauge returns a bar gauge like "[#####-----]  50%", color changes with the ratio.
+func colorfulGauge(ratio float64, width int) string {
+	filled := int(math.Round(ratio * float64(width)))
+	if filled > width {
+		filled = width
+	}
+	if filled < 0 {
+		filled = 0
+	}
+
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+	percent := fmt.Sprintf("%3.0f%%", ratio*100)
+	switch {
+	case ratio >= 0.9:
+		return color.Red.Sprintf("[%s] %s", bar, percent)
+	case ratio >= 0.7:
+		return color.Yellow.Sprintf("[%s] %s", bar, percent)
+	default:
+		return color.Green.Sprintf("[%s] %s", bar, percent)
+	}
+}
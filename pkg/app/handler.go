@@ -1,25 +1,46 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"github.com/TNK-Studio/lazykube/pkg/config"
 	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
 	"github.com/TNK-Studio/lazykube/pkg/kubecli"
 	"github.com/TNK-Studio/lazykube/pkg/log"
+	"github.com/TNK-Studio/lazykube/pkg/utils"
 	"github.com/atotto/clipboard"
 	"github.com/jroimartin/gocui"
 	"github.com/nsf/termbox-go"
 	"github.com/pkg/errors"
+	"io/ioutil"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"math"
 	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
 	resourceNotFound = "Resource not found."
 	noHistory        = "No History."
+	noDifferences    = "No differences found."
 
 	defaultCommand = "/bin/sh"
+
+	cancelApplyManifestOpt     = "Cancel"
+	clientSideApplyManifestOpt = "Client-side apply"
+	serverSideApplyManifestOpt = "Server-side apply"
+
+	cancelRollbackHelmReleaseOpt = "Cancel"
+
+	exportTimeFormat = "20060102-150405"
+
+	logRangeSinceTimeOpt = "Since time ..."
 )
 
 func nextFunctionViewHandler(gui *guilib.Gui, _ *guilib.View) error {
@@ -87,17 +108,23 @@ func nextPageHandler(_ *guilib.Gui, view *guilib.View) error {
 	return view.SetOrigin(ox, newOy)
 }
 
-func previousPageHandler(_ *guilib.Gui, view *guilib.View) error {
+func previousPageHandler(gui *guilib.Gui, view *guilib.View) error {
 	view.Autoscroll = false
 	ox, oy := view.Origin()
+	if oy == 0 {
+		return loadMoreLogs(gui, view)
+	}
 	_, height := view.Size()
 	newOy := int(math.Max(0, float64(oy-height)))
 	return view.SetOrigin(ox, newOy)
 }
 
-func scrollUpHandler(_ *guilib.Gui, view *guilib.View) error {
+func scrollUpHandler(gui *guilib.Gui, view *guilib.View) error {
 	view.Autoscroll = false
 	ox, oy := view.Origin()
+	if oy == 0 {
+		return loadMoreLogs(gui, view)
+	}
 	newOy := int(math.Max(0, float64(oy-2)))
 	return view.SetOrigin(ox, newOy)
 }
@@ -224,7 +251,7 @@ func getResourceNamespaceAndName(gui *guilib.Gui, resourceView *guilib.View) (na
 		return "", "", noResourceSelectedErr
 	}
 
-	if !notResourceSelected(namespace) {
+	if !kubecli.Cli.AllNamespaces() {
 		resourceName := formatResourceName(selected, 0)
 		if notResourceSelected(resourceName) {
 			return "", "", noResourceSelectedErr
@@ -232,6 +259,15 @@ func getResourceNamespaceAndName(gui *guilib.Gui, resourceView *guilib.View) (na
 		return kubecli.Cli.Namespace(), resourceName, nil
 	}
 
+	// Note: Cluster scoped resources have no namespace column even in all namespaces mode.
+	if !hasNamespaceColumn(resourceView) {
+		resourceName := formatResourceName(selected, 0)
+		if notResourceSelected(resourceName) {
+			return "", "", noResourceSelectedErr
+		}
+		return "", resourceName, nil
+	}
+
 	namespace = formatResourceName(selected, 0)
 	resourceName = formatResourceName(selected, 1)
 	if notResourceSelected(resourceName) {
@@ -245,6 +281,25 @@ func getResourceNamespaceAndName(gui *guilib.Gui, resourceView *guilib.View) (na
 	return namespace, resourceName, nil
 }
 
+// hasNamespaceColumn returns whether the first column of resource panel is NAMESPACE.
+func hasNamespaceColumn(resourceView *guilib.View) bool {
+	lines := resourceView.ViewBufferLines()
+	if len(lines) == 0 {
+		return true
+	}
+	columns := strings.Fields(lines[0])
+	return len(columns) > 0 && columns[0] == namespaceColumn
+}
+
+func toggleAllNamespacesHandler(gui *guilib.Gui, _ *guilib.View) error {
+	if kubecli.Cli.AllNamespaces() {
+		switchNamespace(gui, kubecli.Cli.PreviousNamespace())
+		return nil
+	}
+	switchNamespace(gui, "")
+	return nil
+}
+
 func editResourceHandler(gui *guilib.Gui, view *guilib.View) error {
 	var err error
 	var resource, namespace, resourceName string
@@ -330,13 +385,15 @@ func confirmDialogOptionHandler(gui *guilib.Gui, view *guilib.View, relatedViewN
 }
 
 func addCustomResourcePanelHandler(gui *guilib.Gui, _ *guilib.View) error {
-	stream := newStream()
-	kubecli.Cli.APIResources(stream).Run()
-	apiResourcesStr := streamToString(stream)
+	resources, err := kubecli.Cli.ListAPIResources()
+	if err != nil {
+		return setDetailRenderFunc(gui, contentRender(err.Error()))
+	}
 
-	apiResources := strings.Split(apiResourcesStr, "\n")
-	if len(apiResources) > 0 {
-		apiResources = apiResources[1:]
+	// Group qualified names are used, so that resources of different API groups with the same name can be distinguished.
+	apiResources := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		apiResources = append(apiResources, fmt.Sprintf("%-60s %-10t %s", resource.GroupResource(), resource.Namespaced, resource.Kind))
 	}
 
 	if err := showFilterDialog(
@@ -361,10 +418,61 @@ func addCustomResourcePanelHandler(gui *guilib.Gui, _ *guilib.View) error {
 			return nil
 		},
 		func(string) ([]string, error) {
-			if len(apiResources) >= 1 {
-				return apiResources[1:], nil
+			return apiResources, nil
+		},
+		resourceNotFound,
+		false,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+func browseCustomResourceDefinitionsHandler(gui *guilib.Gui, _ *guilib.View) error {
+	crds, err := kubecli.Cli.ListCustomResourceDefinitions()
+	if err != nil {
+		return setDetailRenderFunc(gui, contentRender(err.Error()))
+	}
+
+	// Grouped by API group.
+	crdLines := make([]string, 0, len(crds))
+	for _, crd := range crds {
+		scope := "Cluster"
+		if crd.Namespaced {
+			scope = "Namespaced"
+		}
+		crdLines = append(
+			crdLines,
+			fmt.Sprintf("%-36s %-36s %-30s %-10s %s", crd.Group, crd.Plural, crd.Kind, scope, strings.Join(crd.Versions, ",")),
+		)
+	}
+
+	if err := showFilterDialog(
+		gui,
+		"Filter custom resource definitions by group or name.",
+		func(selected string) error {
+			if selected == "" || selected == resourceNotFound {
+				return nil
 			}
-			return []string{}, nil
+
+			fields := strings.Fields(selected)
+			if len(fields) < 2 {
+				return nil
+			}
+
+			if err := addCustomResourcePanel(gui, fields[1]+"."+fields[0]); err != nil {
+				return err
+			}
+			if err := closeFilterDialog(gui); err != nil {
+				if errors.Is(err, gocui.ErrUnknownView) {
+					return nil
+				}
+				return err
+			}
+			return nil
+		},
+		func(string) ([]string, error) {
+			return crdLines, nil
 		},
 		resourceNotFound,
 		false,
@@ -460,12 +568,7 @@ func containerExecCommandHandler(gui *guilib.Gui, view *guilib.View) error {
 }
 
 func changePodLogsContainerHandler(gui *guilib.Gui, view *guilib.View) error {
-	podView, err := gui.GetView(podViewName)
-	if err != nil {
-		return err
-	}
-
-	namespace, resourceName, err := getResourceNamespaceAndName(gui, podView)
+	namespace, resourceName, err := getLogPodNamespaceAndName(gui, view)
 	if err != nil {
 		if errors.Is(err, noResourceSelectedErr) {
 			return nil
@@ -486,17 +589,9 @@ func changePodLogsContainerHandler(gui *guilib.Gui, view *guilib.View) error {
 			if err := view.SetState(logContainerStateKey, containerName, true); err != nil {
 				return err
 			}
-			if err := view.SetState(viewLastRenderTimeStateKey, nil, true); err != nil {
-				return err
-			}
-			if err := view.SetState(logSinceTimeStateKey, nil, true); err != nil {
-				return err
-			}
-			view.Clear()
-			if err := view.SetOrigin(0, 0); err != nil {
+			if err := reloadLogs(view); err != nil {
 				return err
 			}
-			view.ReRender()
 			if err := gui.FocusView(detailViewName, false); err != nil {
 				return err
 			}
@@ -512,6 +607,183 @@ func changePodLogsContainerHandler(gui *guilib.Gui, view *guilib.View) error {
 	return nil
 }
 
+// reloadLogs clear logs of view and fetch them again.
+func reloadLogs(view *guilib.View) error {
+	if err := view.SetState(viewLastRenderTimeStateKey, nil, true); err != nil {
+		return err
+	}
+	if err := view.SetState(logSinceTimeStateKey, nil, true); err != nil {
+		return err
+	}
+	if err := view.SetState(logCursorsStateKey, nil, true); err != nil {
+		return err
+	}
+	view.Clear()
+	if err := view.SetOrigin(0, 0); err != nil {
+		return err
+	}
+	view.ReRender()
+	return nil
+}
+
+func includeLogsHandler(gui *guilib.Gui, view *guilib.View) error {
+	field := func(filter *logFilter) **regexp.Regexp {
+		return &filter.Include
+	}
+	return showLogRegexpDialog(gui, view, "Include logs matched regexp. (Empty to clear)", currentLogRegexp(view, field), field)
+}
+
+func excludeLogsHandler(gui *guilib.Gui, view *guilib.View) error {
+	field := func(filter *logFilter) **regexp.Regexp {
+		return &filter.Exclude
+	}
+	return showLogRegexpDialog(gui, view, "Exclude logs matched regexp. (Empty to clear)", currentLogRegexp(view, field), field)
+}
+
+func currentLogRegexp(view *guilib.View, field func(filter *logFilter) **regexp.Regexp) string {
+	if current := *field(getLogFilter(view)); current != nil {
+		return current.String()
+	}
+	return ""
+}
+
+func showLogRegexpDialog(gui *guilib.Gui, view *guilib.View, title, defaultValue string, field func(filter *logFilter) **regexp.Regexp) error {
+	filter := getLogFilter(view)
+	return showInputDialog(
+		gui,
+		title,
+		2,
+		func(expr string) error {
+			expr = strings.TrimSpace(expr)
+			if expr == "" {
+				*field(filter) = nil
+			} else {
+				compiled, err := regexp.Compile(expr)
+				if err != nil {
+					return showLogRegexpDialog(gui, view, fmt.Sprintf("Invalid regexp: %s", err), expr, field)
+				}
+				*field(filter) = compiled
+			}
+			filter.Disabled = false
+
+			if err := reloadLogs(view); err != nil {
+				return err
+			}
+			return gui.FocusView(detailViewName, false)
+		},
+		defaultValue,
+	)
+}
+
+var logRangeOptions = []string{
+	"Last 100 lines",
+	"Last 500 lines",
+	"Last 1000 lines",
+	"Last 5000 lines",
+	"Since 5m",
+	"Since 1h",
+	"Since 24h",
+	logRangeSinceTimeOpt,
+}
+
+// selectLogRangeHandler select range of logs, the selected one will be saved as default.
+func selectLogRangeHandler(gui *guilib.Gui, view *guilib.View) error {
+	return showOptionsDialog(
+		gui,
+		fmt.Sprintf("Select range of logs. (Current: %s)", getLogRange(view)),
+		2,
+		func(option string) error {
+			if option == logRangeSinceTimeOpt {
+				return showLogSinceTimeDialog(gui, view, "Show logs since time. (Format: 2006-01-02 15:04:05)")
+			}
+
+			fields := strings.Fields(option)
+			if len(fields) < 2 {
+				return nil
+			}
+
+			r := &logRange{}
+			logsConfig := config.Conf.UserConfig.GetLogsConfig()
+			if fields[0] == "Last" {
+				tail, err := strconv.ParseInt(fields[1], 10, 64)
+				if err != nil {
+					return err
+				}
+				r.Tail = tail
+				logsConfig.Tail, logsConfig.Since = tail, ""
+			} else {
+				since, err := time.ParseDuration(fields[1])
+				if err != nil {
+					return err
+				}
+				r.Tail, r.Since = logsConfig.Tail, since
+				logsConfig.Since = fields[1]
+			}
+			config.Save()
+			return applyLogRange(gui, view, r)
+		},
+		func() []string {
+			return logRangeOptions
+		},
+	)
+}
+
+func showLogSinceTimeDialog(gui *guilib.Gui, view *guilib.View, title string) error {
+	return showInputDialog(
+		gui,
+		title,
+		3,
+		func(value string) error {
+			sinceTime, err := time.ParseInLocation(logRangeTimeFormat, strings.TrimSpace(value), time.Local)
+			if err != nil {
+				return showLogSinceTimeDialog(gui, view, fmt.Sprintf("Invalid time: %s", err))
+			}
+			return applyLogRange(gui, view, &logRange{Tail: config.Conf.UserConfig.GetLogsConfig().Tail, SinceTime: sinceTime})
+		},
+		time.Now().Add(-time.Hour).Format(logRangeTimeFormat),
+	)
+}
+
+func applyLogRange(gui *guilib.Gui, view *guilib.View, r *logRange) error {
+	if err := view.SetState(logRangeStateKey, r, false); err != nil {
+		return err
+	}
+	if err := reloadLogs(view); err != nil {
+		return err
+	}
+	return gui.FocusView(detailViewName, false)
+}
+
+// loadMoreLogs extend range of logs when scrolling past the top of Detail.
+func loadMoreLogs(gui *guilib.Gui, view *guilib.View) error {
+	if view.Name != detailViewName || !isLogNavigation(gui, view) {
+		return nil
+	}
+
+	// Note: Logs are being reloaded, avoid extending the range again.
+	if len(view.ViewBufferLines()) == 0 {
+		return nil
+	}
+
+	if !getLogRange(view).More() {
+		return nil
+	}
+	keepLogsScroll(view)
+	return reloadLogs(view)
+}
+
+func toggleLogFilterHandler(_ *guilib.Gui, view *guilib.View) error {
+	filter := getLogFilter(view)
+	filter.Disabled = !filter.Disabled
+	return reloadLogs(view)
+}
+
+func toggleRawLogsHandler(_ *guilib.Gui, view *guilib.View) error {
+	filter := getLogFilter(view)
+	filter.Raw = !filter.Raw
+	return reloadLogs(view)
+}
+
 func tailLogsHandler(gui *guilib.Gui, view *guilib.View) error {
 	if err := view.SetState(ScrollingLogsStateKey, false, false); err != nil {
 		return err
@@ -670,19 +942,42 @@ func runPodCommandInput(gui *guilib.Gui, namespace, podName, image string) error
 }
 
 func changeContextHandler(gui *guilib.Gui, view *guilib.View) error {
+	currentContext := kubecli.Cli.CurrentContext()
+	contexts := make([]string, 0)
+	for _, ctx := range kubecli.Cli.ListContextDetails() {
+		mark := " "
+		if ctx.Name == currentContext {
+			mark = "*"
+		}
+		namespace := ctx.Namespace
+		if namespace == "" {
+			namespace = "-"
+		}
+		contexts = append(contexts, fmt.Sprintf("%s %-40s cluster: %-30s user: %-30s namespace: %s", mark, ctx.Name, ctx.Cluster, ctx.User, namespace))
+	}
+
+	title := "Selected a context to swicth."
+	if config.Conf.UserConfig.PersistContext {
+		title = "Selected a context to swicth. (Persist to kubeconfig)"
+	}
+
 	if err := showFilterDialog(
 		gui,
-		"Selected a context to swicth.",
+		title,
 		func(confirmed string) error {
-			kubecli.Cli.SetCurrentContext(confirmed)
-			gui.ReRenderAll()
+			fields := strings.Fields(strings.TrimPrefix(confirmed, "*"))
+			if len(fields) == 0 {
+				return nil
+			}
+
+			switchContext(gui, fields[0])
 			if err := gui.FocusView(clusterInfoViewName, false); err != nil {
 				return err
 			}
 			return nil
 		},
 		func(inputted string) ([]string, error) {
-			return kubecli.Cli.ListContexts(), nil
+			return contexts, nil
 		},
 		"No contexts.",
 		false,
@@ -692,3 +987,758 @@ func changeContextHandler(gui *guilib.Gui, view *guilib.View) error {
 
 	return nil
 }
+
+// switchContext switch to context and restore the last used namespace of it.
+func switchContext(gui *guilib.Gui, context string) {
+	addRecentNamespace(kubecli.Cli.Namespace())
+	kubecli.Cli.SetCurrentContext(context)
+	if config.Conf.UserConfig.PersistContext {
+		if err := kubecli.Cli.PersistCurrentContext(); err != nil {
+			log.Logger.Warningf("switchContext - kubecli.Cli.PersistCurrentContext() error %s", err)
+		}
+	}
+
+	namespace := kubecli.Cli.ContextNamespace()
+	if recents := contextNamespaces().Recents; len(recents) > 0 {
+		namespace = recents[0]
+	}
+	// Empty namespace means all namespaces mode, which should only be turned on explicitly.
+	if namespace == "" {
+		namespace = v1.NamespaceDefault
+	}
+	switchNamespace(gui, namespace)
+	gui.ReRenderAll()
+}
+
+func persistContextHandler(gui *guilib.Gui, _ *guilib.View) error {
+	config.Conf.UserConfig.PersistContext = !config.Conf.UserConfig.PersistContext
+	config.Save()
+	gui.ReRenderViews(clusterInfoViewName)
+	return nil
+}
+
+func applyManifestHandler(gui *guilib.Gui, _ *guilib.View) error {
+	if err := showFilterDialog(
+		gui,
+		"Please input manifest file or directory path.",
+		func(manifestPath string) error {
+			if manifestPath == "" || manifestPath == noHistory {
+				return nil
+			}
+
+			if err := diffManifest(gui, manifestPath); err != nil {
+				return err
+			}
+			if err := closeFilterDialog(gui); err != nil {
+				if errors.Is(err, gocui.ErrUnknownView) {
+					return nil
+				}
+				return err
+			}
+			return nil
+		},
+		func(inputted string) ([]string, error) {
+			paths := make([]string, 0)
+			if config.Conf.UserConfig.History.ManifestPathHistory != nil {
+				paths = append(paths, config.Conf.UserConfig.History.ManifestPathHistory...)
+			}
+
+			for _, completion := range utils.CompleteFilePath(inputted) {
+				if !utils.StringInSlice(completion, paths) {
+					paths = append(paths, completion)
+				}
+			}
+			return paths, nil
+		},
+		noHistory,
+		true,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+func diffManifest(gui *guilib.Gui, manifestPath string) error {
+	stream := newStream()
+	manifestCmd(kubecli.Cli.Diff(stream), manifestPath).Run()
+
+	diff := streamToString(stream)
+	if strings.TrimSpace(diff) == "" {
+		diff = noDifferences
+	}
+
+	if err := setDetailRenderFunc(gui, contentRender(colorfulDiff(diff))); err != nil {
+		return err
+	}
+	if err := gui.FocusView(detailViewName, false); err != nil {
+		return err
+	}
+
+	if err := showOptionsDialog(
+		gui,
+		fmt.Sprintf("Confirm to apply '%s' ?", manifestPath),
+		1,
+		func(option string) error {
+			if option == clientSideApplyManifestOpt || option == serverSideApplyManifestOpt {
+				if err := applyManifest(gui, manifestPath, option == serverSideApplyManifestOpt); err != nil {
+					return err
+				}
+			}
+			return gui.FocusView(detailViewName, false)
+		},
+		func() []string {
+			return []string{cancelApplyManifestOpt, clientSideApplyManifestOpt, serverSideApplyManifestOpt}
+		},
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+func applyManifest(gui *guilib.Gui, manifestPath string, serverSide bool) error {
+	stream := newStream()
+	cmd := manifestCmd(kubecli.Cli.Apply(stream), manifestPath)
+	if serverSide {
+		cmd.SetFlag("server-side", "true")
+	}
+	cmd.Run()
+
+	config.Conf.UserConfig.History.AddManifestPathHistory(manifestPath)
+	config.Save()
+
+	if err := setDetailRenderFunc(gui, contentRender(streamToString(stream))); err != nil {
+		return err
+	}
+	gui.ReRenderViews(resizeableViews...)
+	return nil
+}
+
+func manifestCmd(cmd *kubecli.Cmd, manifestPath string) *kubecli.Cmd {
+	filePath := utils.FilePath(manifestPath)
+	cmd.SetFlag("filename", filePath)
+	if utils.IsDirector(filePath) {
+		cmd.SetFlag("recursive", "true")
+	}
+	return cmd
+}
+
+func switchConfigYAMLModeHandler(gui *guilib.Gui, _ *guilib.View) error {
+	detailView, err := gui.GetView(detailViewName)
+	if err != nil {
+		return err
+	}
+
+	mode := getConfigYAMLMode(detailView)
+	nextMode := config.ConfigYAMLModes[0]
+	for index, each := range config.ConfigYAMLModes {
+		if each == mode && index+1 < len(config.ConfigYAMLModes) {
+			nextMode = config.ConfigYAMLModes[index+1]
+		}
+	}
+
+	if err := detailView.SetState(configYAMLModeStateKey, nextMode, true); err != nil {
+		return err
+	}
+	if err := clearLastRenderTime(gui, detailViewName); err != nil {
+		return err
+	}
+	return nil
+}
+
+func rollbackHelmReleaseHandler(gui *guilib.Gui, view *guilib.View) error {
+	namespace, name, err := getResourceNamespaceAndName(gui, view)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			return nil
+		}
+		return err
+	}
+
+	history, err := kubecli.Cli.GetHelmReleaseHistory(context.Background(), namespace, name)
+	if err != nil {
+		return err
+	}
+	if len(history) < 2 {
+		return showOptionsDialog(
+			gui,
+			fmt.Sprintf("Helm release '%s' has no previous revision.", name),
+			1,
+			func(string) error {
+				return gui.FocusView(helmViewName, false)
+			},
+			func() []string {
+				return []string{"OK"}
+			},
+		)
+	}
+
+	options := []string{cancelRollbackHelmReleaseOpt}
+	for _, release := range history[1:] {
+		options = append(
+			options,
+			fmt.Sprintf("%d   %s-%s   %s", release.Revision, release.Chart, release.ChartVersion, release.Description),
+		)
+	}
+
+	title := fmt.Sprintf("Confirm to rollback helm release '%s' ? Select a revision.", name)
+	if !kubecli.HelmInstalled() {
+		title = fmt.Sprintf("Helm is not installed, the manifest will be applied without running hooks. %s", title)
+	}
+
+	return showOptionsDialog(
+		gui,
+		title,
+		1,
+		func(selected string) error {
+			if selected == "" || selected == cancelRollbackHelmReleaseOpt {
+				return gui.FocusView(helmViewName, false)
+			}
+
+			revision, err := strconv.Atoi(strings.Fields(selected)[0])
+			if err != nil {
+				return err
+			}
+
+			stream := newStream()
+			if err := kubecli.Cli.RollbackHelmRelease(context.Background(), stream, namespace, name, revision); err != nil {
+				log.Logger.Warningf("rollbackHelmReleaseHandler - kubecli.Cli.RollbackHelmRelease('%s', '%s', %d) error %s", namespace, name, revision, err)
+				fmt.Fprintln(stream.ErrOut, err)
+			} else {
+				fmt.Fprintf(stream.Out, "Rollback was a success! Helm release '%s' rolled back to revision %d.\n", name, revision)
+			}
+
+			if err := setDetailRenderFunc(gui, contentRender(streamToString(stream))); err != nil {
+				return err
+			}
+			gui.ReRenderViews(resizeableViews...)
+			return gui.FocusView(helmViewName, false)
+		},
+		func() []string {
+			return options
+		},
+	)
+}
+
+func triggerCronJobHandler(gui *guilib.Gui, view *guilib.View) error {
+	namespace, name, err := getResourceNamespaceAndName(gui, view)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			return nil
+		}
+		return err
+	}
+
+	var content string
+	job, err := kubecli.Cli.TriggerCronJob(context.Background(), namespace, name)
+	if err != nil {
+		log.Logger.Warningf("triggerCronJobHandler - kubecli.Cli.TriggerCronJob('%s', '%s') error %s", namespace, name, err)
+		content = err.Error()
+	} else {
+		content = fmt.Sprintf("job.batch/%s created\n", job.Name)
+	}
+
+	if err := setDetailRenderFunc(gui, contentRender(content)); err != nil {
+		return err
+	}
+	view.ReRender()
+	return nil
+}
+
+func suspendCronJobHandler(gui *guilib.Gui, view *guilib.View) error {
+	namespace, name, err := getResourceNamespaceAndName(gui, view)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			return nil
+		}
+		return err
+	}
+
+	suspended, err := kubecli.Cli.CronJobSuspended(namespace, name)
+	if err != nil {
+		return setDetailRenderFunc(gui, contentRender(err.Error()))
+	}
+
+	stream := newStream()
+	cli(namespace).Patch(stream, "cronjobs", name).
+		SetFlag("patch", fmt.Sprintf(`{"spec":{"suspend":%t}}`, !suspended)).
+		Run()
+
+	if err := setDetailRenderFunc(gui, contentRender(streamToString(stream))); err != nil {
+		return err
+	}
+	view.ReRender()
+	return nil
+}
+
+func deleteFinishedJobsHandler(gui *guilib.Gui, view *guilib.View) error {
+	namespace, name, err := getResourceNamespaceAndName(gui, view)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			return nil
+		}
+		return err
+	}
+
+	content := new(strings.Builder)
+	deleted, err := kubecli.Cli.DeleteFinishedCronJobJobs(context.Background(), namespace, name)
+	for _, jobName := range deleted {
+		fmt.Fprintf(content, "job.batch \"%s\" deleted\n", jobName)
+	}
+	if err != nil {
+		log.Logger.Warningf("deleteFinishedJobsHandler - kubecli.Cli.DeleteFinishedCronJobJobs('%s', '%s') error %s", namespace, name, err)
+		fmt.Fprintln(content, err)
+	}
+	if content.Len() == 0 {
+		fmt.Fprintln(content, noJobsFound)
+	}
+
+	if err := setDetailRenderFunc(gui, contentRender(content.String())); err != nil {
+		return err
+	}
+	view.ReRender()
+	return nil
+}
+
+func viewJobPodLogsHandler(gui *guilib.Gui, view *guilib.View) error {
+	cronJobView, err := gui.GetView(cronJobViewName)
+	if err != nil {
+		return err
+	}
+
+	namespace, name, err := getResourceNamespaceAndName(gui, cronJobView)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			return nil
+		}
+		return err
+	}
+
+	jobs, err := kubecli.Cli.ListCronJobJobs(context.Background(), namespace, name)
+	if err != nil {
+		return err
+	}
+
+	jobsPods, err := kubecli.Cli.ListJobsPods(context.Background(), namespace, jobs)
+	if err != nil {
+		return err
+	}
+
+	pods := make([]string, 0)
+	for _, job := range jobs {
+		for _, pod := range jobsPods[job.Name] {
+			pods = append(pods, pod.Name)
+		}
+	}
+
+	return showOptionsDialog(
+		gui,
+		"Please select a pod to view logs.",
+		1,
+		func(podName string) error {
+			if podName == "" {
+				return nil
+			}
+
+			clearDetailViewState(gui)
+			if err := view.SetState(logPodStateKey, fmt.Sprintf("%s/%s", namespace, podName), true); err != nil {
+				return err
+			}
+			if err := setDetailRenderFunc(gui, reRenderInterval(podLogsRender, reRenderIntervalDuration)); err != nil {
+				return err
+			}
+			view.Autoscroll = true
+			view.ReRender()
+			return gui.FocusView(detailViewName, false)
+		},
+		func() []string {
+			return pods
+		},
+	)
+}
+
+func exploreAPIResourcesHandler(gui *guilib.Gui, _ *guilib.View) error {
+	apiResources, err := kubecli.Cli.ListAPIResources()
+	if err != nil {
+		return setDetailRenderFunc(gui, contentRender(err.Error()))
+	}
+
+	apiResourceMap := make(map[string]*kubecli.APIResource)
+	apiResourceLines := make([]string, 0, len(apiResources))
+	for _, apiResource := range apiResources {
+		// Resources like "tokenreviews" and "bindings" can not be listed, so they have no instances to explore.
+		if !apiResource.HasVerb("list") {
+			continue
+		}
+		shortNames := "-"
+		if len(apiResource.ShortNames) > 0 {
+			shortNames = strings.Join(apiResource.ShortNames, ",")
+		}
+		apiResourceMap[apiResource.FullName()] = apiResource
+		apiResourceLines = append(
+			apiResourceLines,
+			fmt.Sprintf(
+				"%-56s %-12s %-6t %-36s [%s]",
+				apiResource.FullName(),
+				shortNames,
+				apiResource.Namespaced,
+				apiResource.Kind,
+				strings.Join(apiResource.Verbs, " "),
+			),
+		)
+	}
+
+	return showFilterDialog(
+		gui,
+		"Filter api resources. (NAME SHORTNAMES NAMESPACED KIND VERBS)",
+		func(selected string) error {
+			if selected == "" || selected == resourceNotFound {
+				return nil
+			}
+
+			apiResource, ok := apiResourceMap[strings.Fields(selected)[0]]
+			if !ok {
+				return nil
+			}
+			return exploreAPIResourceInstances(gui, apiResource)
+		},
+		func(string) ([]string, error) {
+			return apiResourceLines, nil
+		},
+		resourceNotFound,
+		false,
+	)
+}
+
+// exploreAPIResourceInstances list instances of resource across namespaces, then open the selected one in config or describe.
+func exploreAPIResourceInstances(gui *guilib.Gui, apiResource *kubecli.APIResource) error {
+	stream := newStream()
+	cmd := kubecli.Cli.Get(stream, apiResource.FullName())
+	if apiResource.Namespaced {
+		cmd.SetFlag("all-namespaces", "true")
+	}
+	cmd.Run()
+	instances := streamToString(stream)
+
+	if err := setDetailRenderFunc(gui, contentRender(instances)); err != nil {
+		return err
+	}
+
+	instanceLines := strings.Split(strings.TrimSpace(instances), "\n")
+	if len(instanceLines) > 0 {
+		instanceLines = instanceLines[1:]
+	}
+
+	return showFilterDialog(
+		gui,
+		fmt.Sprintf("Filter %s.", apiResource.Name),
+		func(selected string) error {
+			if selected == "" || selected == resourceNotFound {
+				return nil
+			}
+
+			fields := strings.Fields(selected)
+			namespace, name := "", fields[0]
+			if apiResource.Namespaced && len(fields) > 1 {
+				namespace, name = fields[0], fields[1]
+			}
+
+			return showOptionsDialog(
+				gui,
+				fmt.Sprintf("Open %s '%s' in", apiResource.Kind, name),
+				1,
+				func(opt string) error {
+					var render guilib.ViewHandler
+					switch opt {
+					case navigationOptConfig:
+						render = func(_ *guilib.Gui, view *guilib.View) error {
+							return resourceYAMLRender(view, namespace, apiResource.FullName(), name)
+						}
+					case navigationOptDescribe:
+						render = func(_ *guilib.Gui, view *guilib.View) error {
+							cli(namespace).Describe(viewStreams(view), apiResource.FullName(), name).Run()
+							return nil
+						}
+					default:
+						return nil
+					}
+
+					if err := clearLastRenderTime(gui, detailViewName); err != nil {
+						return err
+					}
+					if err := setDetailRenderFunc(gui, reRenderInterval(clearBeforeRender(render), reRenderIntervalDuration)); err != nil {
+						return err
+					}
+					return gui.FocusView(detailViewName, false)
+				},
+				func() []string {
+					return []string{navigationOptConfig, navigationOptDescribe}
+				},
+			)
+		},
+		func(string) ([]string, error) {
+			return instanceLines, nil
+		},
+		resourceNotFound,
+		false,
+	)
+}
+
+// switchNamespaceHandler quick switch namespace, favorite and recently used namespaces will be ranked first.
+func switchNamespaceHandler(gui *guilib.Gui, _ *guilib.View) error {
+	namespaces := contextNamespaces()
+	ranked := make([]string, 0)
+	marks := make(map[string]string)
+	for _, namespace := range namespaces.Favorites {
+		if _, ok := marks[namespace]; !ok {
+			ranked = append(ranked, namespace)
+			marks[namespace] = "(favorite)"
+		}
+	}
+	for _, namespace := range namespaces.Recents {
+		if _, ok := marks[namespace]; !ok {
+			ranked = append(ranked, namespace)
+			marks[namespace] = "(recent)"
+		}
+	}
+
+	others := make([]string, 0)
+	namespaceList, err := kubecli.Cli.GetNamespaces(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		log.Logger.Warningf("switchNamespaceHandler - kubecli.Cli.GetNamespaces error %s", err)
+	} else {
+		for _, namespace := range namespaceList.Items {
+			if _, ok := marks[namespace.Name]; !ok {
+				others = append(others, namespace.Name)
+			}
+		}
+	}
+	sort.Strings(others)
+
+	lines := make([]string, 0, len(ranked)+len(others))
+	for _, namespace := range append(ranked, others...) {
+		lines = append(lines, strings.TrimSpace(fmt.Sprintf("%-60s %s", namespace, marks[namespace])))
+	}
+
+	return showFuzzyFilterDialog(
+		gui,
+		"Select a namespace to switch.",
+		func(confirmed string) error {
+			namespace := formatSelectedNamespace(confirmed)
+			if notResourceSelected(namespace) {
+				return nil
+			}
+
+			switchNamespace(gui, namespace)
+			addRecentNamespace(namespace)
+			return gui.ReturnPreviousView()
+		},
+		func(string) ([]string, error) {
+			return lines, nil
+		},
+		"No namespaces.",
+		false,
+	)
+}
+
+func favoriteNamespaceHandler(_ *guilib.Gui, view *guilib.View) error {
+	namespace := formatSelectedNamespace(view.SelectedLine)
+	if notResourceSelected(namespace) {
+		return nil
+	}
+
+	contextNamespaces().ToggleFavorite(namespace)
+	config.Save()
+	view.ReRender()
+	return nil
+}
+
+func onlyFavoriteNamespacesHandler(_ *guilib.Gui, view *guilib.View) error {
+	config.Conf.UserConfig.OnlyFavoriteNamespaces = !config.Conf.UserConfig.OnlyFavoriteNamespaces
+	config.Save()
+	if err := view.ResetCursorOrigin(); err != nil {
+		return err
+	}
+	view.ReRender()
+	return nil
+}
+
+// contextNamespaces returns favorite and recent namespaces of current context.
+func contextNamespaces() *config.ContextNamespaces {
+	return config.Conf.UserConfig.GetContextNamespaces(kubecli.Cli.CurrentContext())
+}
+
+func addRecentNamespace(namespace string) {
+	if namespace == "" {
+		return
+	}
+
+	namespaces := contextNamespaces()
+	if len(namespaces.Recents) > 0 && namespaces.Recents[0] == namespace {
+		return
+	}
+	namespaces.AddRecent(namespace)
+	config.Save()
+}
+
+// selectCompareContextsHandler toggle contexts to compare, dialog will be shown again until it is closed.
+func selectCompareContextsHandler(gui *guilib.Gui, view *guilib.View) error {
+	current := kubecli.Cli.CurrentContext()
+	contexts := make([]string, 0)
+	for _, ctx := range kubecli.Cli.ListContexts() {
+		if ctx == current {
+			continue
+		}
+		mark := "[ ]"
+		if utils.StringInSlice(ctx, config.Conf.UserConfig.CompareContexts) {
+			mark = "[x]"
+		}
+		contexts = append(contexts, fmt.Sprintf("%s %s", mark, ctx))
+	}
+
+	return showFilterDialog(
+		gui,
+		"Select contexts to compare with current context. (Esc to finish)",
+		func(confirmed string) error {
+			fields := strings.Fields(strings.TrimPrefix(strings.TrimPrefix(confirmed, "[ ]"), "[x]"))
+			if len(fields) == 0 {
+				return nil
+			}
+
+			config.Conf.UserConfig.ToggleCompareContext(fields[0])
+			config.Save()
+			gui.ReRenderViews(detailViewName)
+			return selectCompareContextsHandler(gui, view)
+		},
+		func(string) ([]string, error) {
+			return contexts, nil
+		},
+		"No other contexts.",
+		false,
+	)
+}
+
+func saveToFileHandler(gui *guilib.Gui, view *guilib.View) error {
+	namespace, name := detailResourceNamespaceAndName(gui, view)
+	defaultPath := path.Join(
+		config.LazykubeHomePath,
+		"exports",
+		exportPathEscape(kubecli.Cli.CurrentContext()),
+		exportPathEscape(namespace),
+		fmt.Sprintf("%s-%s.log", exportPathEscape(name), time.Now().Format(exportTimeFormat)),
+	)
+
+	return showInputDialog(
+		gui,
+		"Save to file.",
+		2,
+		func(filePath string) error {
+			filePath = utils.FilePath(strings.TrimSpace(filePath))
+			if filePath == "" {
+				return gui.FocusView(detailViewName, false)
+			}
+
+			title := fmt.Sprintf("Saved to '%s'.", filePath)
+			if err := saveDetailToFile(gui, view, filePath); err != nil {
+				title = fmt.Sprintf("Save to file failed: %s", err)
+			}
+			return showOptionsDialog(
+				gui,
+				title,
+				2,
+				func(string) error {
+					return gui.FocusView(detailViewName, false)
+				},
+				func() []string {
+					return []string{"OK"}
+				},
+			)
+		},
+		defaultPath,
+	)
+}
+
+// detailResourceNamespaceAndName returns namespace and name of resource which is shown in Detail.
+func detailResourceNamespaceAndName(gui *guilib.Gui, view *guilib.View) (string, string) {
+	namespace, name := kubecli.Cli.Namespace(), "detail"
+	if activeView == nil {
+		return namespace, name
+	}
+
+	var err error
+	var resourceNamespace, resourceName string
+	if activeNavigationOpt == navigationOptLog {
+		resourceNamespace, resourceName, err = getLogPodNamespaceAndName(gui, view)
+	} else {
+		resourceNamespace, resourceName, err = getResourceNamespaceAndName(gui, activeView)
+	}
+	if err != nil || resourceName == "" {
+		return namespace, name
+	}
+	if resourceNamespace == "" {
+		resourceNamespace = "_cluster"
+	}
+	return resourceNamespace, resourceName
+}
+
+// saveDetailToFile write full logs when Detail is showing logs, otherwise the view buffer.
+func saveDetailToFile(gui *guilib.Gui, view *guilib.View, filePath string) error {
+	content := view.ViewBuffer()
+	switch activeNavigationOpt {
+	case navigationOptLog:
+		namespace, name, err := getLogPodNamespaceAndName(gui, view)
+		if err != nil {
+			return err
+		}
+
+		stream := newStream()
+		cmd := cli(namespace).Logs(stream, name).SetFlag("prefix", "true").SetFlag("timestamps", "true")
+		if val, _ := view.GetState(logContainerStateKey); val != nil && val.(string) != "" {
+			cmd.SetFlag("container", val.(string))
+		} else {
+			cmd.SetFlag("all-containers", "true")
+		}
+		cmd.Run()
+		content = streamToString(stream)
+	case navigationOptPodsLog:
+		var logs *strings.Builder
+		err := podsSelectorRenderHelper(func(namespace string, labelsArr []string) error {
+			pods, err := cli(namespace).ListPodsBySelector(namespace, strings.Join(labelsArr, ","))
+			if err != nil {
+				return err
+			}
+			lines, errs, err := cli(namespace).MergedLogs(namespace, pods, func(string, string) time.Time {
+				return time.Time{}
+			}, -1, 0)
+			if err != nil {
+				return err
+			}
+
+			logs = new(strings.Builder)
+			for _, logsErr := range errs {
+				fmt.Fprintln(logs, logsErr.Error())
+			}
+			for _, line := range lines {
+				fmt.Fprintf(logs, "%s [%s/%s] %s\n", line.Time.Format(time.RFC3339Nano), line.Pod, line.Container, line.Message)
+			}
+			return nil
+		})(gui, view)
+		if err != nil {
+			return err
+		}
+		if logs != nil {
+			content = logs.String()
+		}
+	}
+
+	if err := os.MkdirAll(path.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filePath, []byte(content), 0644)
+}
+
+func exportPathEscape(name string) string {
+	if name == "" {
+		return "_"
+	}
+	return strings.NewReplacer("/", "_", ":", "_", string(os.PathSeparator), "_").Replace(name)
+}
@@ -48,9 +48,35 @@ const (
 	containerExecCommandActionName      = "Execute the command"
 	changePodLogsContainerActionName    = "Change pod logs container"
 	tailLogsActionName                  = "Tail logs"
-	scrollLogsActionName                          = "Scroll logs"
+	scrollLogsActionName                = "Scroll logs"
 	runPodActionName                    = "Run a pod with an image"
 	changeContextActionName             = "Change context"
+	applyManifestActionName             = "Apply manifest"
+	switchConfigYAMLModeActionName      = "Switch config YAML mode"
+	deleteFunctionPanelActionName       = "Delete panel"
+	addHelmPanelActionName              = "Add helm releases panel"
+	rollbackHelmReleaseActionName       = "Rollback helm release"
+	addCronJobPanelActionName           = "Add cronjobs panel"
+	triggerCronJobActionName            = "Trigger cronjob now"
+	suspendCronJobActionName            = "Suspend/Resume cronjob"
+	deleteFinishedJobsActionName        = "Delete finished jobs"
+	viewJobPodLogsActionName            = "View job pod logs"
+	addStoragePanelActionName           = "Add storage panel"
+	addIngressPanelActionName           = "Add ingresses panel"
+	browseCRDsActionName                = "Browse custom resource definitions"
+	exploreAPIResourcesActionName       = "Explore api resources"
+	toggleAllNamespacesActionName       = "Toggle all namespaces"
+	switchNamespaceActionName           = "Switch namespace"
+	favoriteNamespaceActionName         = "Favorite namespace"
+	onlyFavoriteNamespacesActionName    = "Toggle only favorite namespaces"
+	persistContextActionName            = "Toggle persist context to kubeconfig"
+	selectCompareContextsActionName     = "Select contexts to compare"
+	includeLogsActionName               = "Include logs"
+	excludeLogsActionName               = "Exclude logs"
+	toggleLogFilterActionName           = "Toggle log filter"
+	toggleRawLogsActionName             = "Toggle raw logs"
+	saveToFileActionName                = "Save to file"
+	selectLogRangeActionName            = "Select log range"
 )
 
 var (
@@ -92,9 +118,35 @@ var (
 		inputDialogEnter:                    {gocui.KeyEnter},
 		changePodLogsContainerActionName:    {'c'},
 		tailLogsActionName:                  {'t'},
-		scrollLogsActionName:                          {'s'},
+		scrollLogsActionName:                {'s'},
 		runPodActionName:                    {'r'},
 		changeContextActionName:             {'~'},
+		applyManifestActionName:             {'a'},
+		switchConfigYAMLModeActionName:      {'y'},
+		deleteFunctionPanelActionName:       {'-'},
+		addHelmPanelActionName:              {'H'},
+		rollbackHelmReleaseActionName:       {'r'},
+		addCronJobPanelActionName:           {'J'},
+		triggerCronJobActionName:            {'t'},
+		suspendCronJobActionName:            {'s'},
+		deleteFinishedJobsActionName:        {'d'},
+		viewJobPodLogsActionName:            {'p'},
+		addStoragePanelActionName:           {'S'},
+		addIngressPanelActionName:           {'I'},
+		browseCRDsActionName:                {'D'},
+		exploreAPIResourcesActionName:       {'E'},
+		toggleAllNamespacesActionName:       {'A'},
+		switchNamespaceActionName:           {gocui.KeyCtrlN},
+		favoriteNamespaceActionName:         {'*'},
+		onlyFavoriteNamespacesActionName:    {'F'},
+		persistContextActionName:            {'P'},
+		selectCompareContextsActionName:     {'K'},
+		includeLogsActionName:               {'g'},
+		excludeLogsActionName:               {'v'},
+		toggleLogFilterActionName:           {'F'},
+		toggleRawLogsActionName:             {'R'},
+		saveToFileActionName:                {'w'},
+		selectLogRangeActionName:            {'L'},
 	}
 )
 
@@ -0,0 +1,275 @@
+package app
+
+import (
+	"encoding/json"
+	"github.com/TNK-Studio/lazykube/pkg/config"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"github.com/TNK-Studio/lazykube/pkg/log"
+	"github.com/TNK-Studio/lazykube/pkg/utils"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	metricsHistoryFile  = "metrics.json"
+	metricsSaveInterval = time.Minute
+)
+
+var podMetricsCollector *metricsCollector
+
+// metricsSample usages of series sampled at Time.
+type metricsSample struct {
+	Time   time.Time                        `json:"time"`
+	Usages map[string]kubecli.ResourceUsage `json:"usages"`
+}
+
+// metricsRing ring buffer of metrics samples, the oldest sample will be overwritten when it is full.
+type metricsRing struct {
+	samples []*metricsSample
+	start   int
+}
+
+func newMetricsRing(capacity int) *metricsRing {
+	return &metricsRing{samples: make([]*metricsSample, 0, capacity)}
+}
+
+func (ring *metricsRing) Push(sample *metricsSample) {
+	if len(ring.samples) < cap(ring.samples) {
+		ring.samples = append(ring.samples, sample)
+		return
+	}
+	ring.samples[ring.start] = sample
+	ring.start = (ring.start + 1) % len(ring.samples)
+}
+
+// Samples returns samples from the oldest to the latest.
+func (ring *metricsRing) Samples() []*metricsSample {
+	samples := make([]*metricsSample, 0, len(ring.samples))
+	samples = append(samples, ring.samples[ring.start:]...)
+	return append(samples, ring.samples[:ring.start]...)
+}
+
+func (ring *metricsRing) Last() *metricsSample {
+	if len(ring.samples) == 0 {
+		return nil
+	}
+	return ring.samples[(ring.start+len(ring.samples)-1)%len(ring.samples)]
+}
+
+// metricsTarget context and namespace to collect, empty namespace means all namespaces.
+type metricsTarget struct {
+	context   string
+	namespace string
+}
+
+// metricsCollector samples containers metrics of pods in current namespace in background.
+type metricsCollector struct {
+	mu        sync.RWMutex
+	interval  time.Duration
+	retention time.Duration
+	capacity  int
+	// filePath is empty if history should not be persisted.
+	filePath string
+	lastSave time.Time
+	rings    map[string]*metricsRing
+	stop     chan struct{}
+	// done is closed when the collecting goroutine exits.
+	done chan struct{}
+	// target is set by UI, kubecli.Cli should not be used in background since it is changed when switching namespace.
+	target metricsTarget
+	// cli and cliTarget are only used by the collecting goroutine.
+	cli       *kubecli.KubeCLI
+	cliTarget metricsTarget
+}
+
+func newMetricsCollector(conf *config.MetricsConfig) *metricsCollector {
+	interval := conf.IntervalDuration()
+	retention := conf.RetentionDuration()
+	capacity := int(retention / interval)
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	collector := &metricsCollector{
+		interval:  interval,
+		retention: retention,
+		capacity:  capacity,
+		rings:     make(map[string]*metricsRing),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	if conf.Persist {
+		collector.filePath = path.Join(config.LazykubeHomePath, metricsHistoryFile)
+	}
+	return collector
+}
+
+func podMetricsKey(context, namespace, name string) string {
+	return path.Join(context, namespace, name)
+}
+
+// Watch set context and namespace to collect, it takes effect from the next collecting.
+func (collector *metricsCollector) Watch(context, namespace string) {
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	collector.target = metricsTarget{context: context, namespace: namespace}
+}
+
+// Start load persisted history and start collecting.
+func (collector *metricsCollector) Start() {
+	if err := collector.load(); err != nil {
+		log.Logger.Warningf("metricsCollector.Start - collector.load() error %s", err)
+	}
+
+	go func() {
+		defer close(collector.done)
+		ticker := time.NewTicker(collector.interval)
+		defer ticker.Stop()
+
+		collector.collect()
+		for {
+			select {
+			case <-collector.stop:
+				return
+			case <-ticker.C:
+				collector.collect()
+			}
+		}
+	}()
+}
+
+// Stop stop collecting, wait for the collecting goroutine to exit and save history.
+func (collector *metricsCollector) Stop() {
+	close(collector.stop)
+	<-collector.done
+	if err := collector.save(); err != nil {
+		log.Logger.Warningf("metricsCollector.Stop - collector.save() error %s", err)
+	}
+}
+
+func (collector *metricsCollector) collect() {
+	collector.mu.RLock()
+	target := collector.target
+	collector.mu.RUnlock()
+
+	if collector.cli == nil || collector.cliTarget != target {
+		collector.cli = kubecli.NewKubeCLIWithContext(target.context, target.namespace)
+		collector.cliTarget = target
+	}
+	metrics, err := collector.cli.ListPodsContainersMetrics(target.namespace, target.namespace == "", nil)
+	if err != nil {
+		log.Logger.Warningf("metricsCollector.collect - cli.ListPodsContainersMetrics() error %s", err)
+		return
+	}
+
+	collector.mu.Lock()
+	for _, podMetrics := range metrics {
+		key := podMetricsKey(target.context, podMetrics.Namespace, podMetrics.Name)
+		ring, ok := collector.rings[key]
+		if !ok {
+			ring = newMetricsRing(collector.capacity)
+			collector.rings[key] = ring
+		}
+
+		// Metrics server may not have a new sample since the last collecting.
+		if last := ring.Last(); last != nil && !podMetrics.Timestamp.After(last.Time) {
+			continue
+		}
+		ring.Push(&metricsSample{Time: podMetrics.Timestamp, Usages: podMetrics.Containers})
+	}
+	collector.prune()
+	lastSave := collector.lastSave
+	collector.mu.Unlock()
+
+	if collector.filePath != "" && time.Since(lastSave) >= metricsSaveInterval {
+		if err := collector.save(); err != nil {
+			log.Logger.Warningf("metricsCollector.collect - collector.save() error %s", err)
+		}
+	}
+}
+
+// prune remove history of pods which have no sample in retention.
+func (collector *metricsCollector) prune() {
+	expired := time.Now().Add(-collector.retention)
+	for key, ring := range collector.rings {
+		if last := ring.Last(); last == nil || last.Time.Before(expired) {
+			delete(collector.rings, key)
+		}
+	}
+}
+
+// History returns samples of pod in retention from the oldest to the latest.
+func (collector *metricsCollector) History(context, namespace, name string) []*metricsSample {
+	collector.mu.RLock()
+	defer collector.mu.RUnlock()
+
+	ring, ok := collector.rings[podMetricsKey(context, namespace, name)]
+	if !ok {
+		return nil
+	}
+
+	expired := time.Now().Add(-collector.retention)
+	samples := ring.Samples()
+	index := sort.Search(len(samples), func(i int) bool {
+		return samples[i].Time.After(expired)
+	})
+	return samples[index:]
+}
+
+func (collector *metricsCollector) load() error {
+	if collector.filePath == "" || !utils.FileExited(collector.filePath) {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(collector.filePath)
+	if err != nil {
+		return err
+	}
+
+	history := make(map[string][]*metricsSample)
+	if err := json.Unmarshal(content, &history); err != nil {
+		return err
+	}
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	for key, samples := range history {
+		ring := newMetricsRing(collector.capacity)
+		for _, sample := range samples {
+			ring.Push(sample)
+		}
+		collector.rings[key] = ring
+	}
+	collector.prune()
+	return nil
+}
+
+func (collector *metricsCollector) save() error {
+	if collector.filePath == "" {
+		return nil
+	}
+
+	collector.mu.Lock()
+	history := make(map[string][]*metricsSample)
+	for key, ring := range collector.rings {
+		history[key] = ring.Samples()
+	}
+	collector.lastSave = time.Now()
+	collector.mu.Unlock()
+
+	content, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so that history will not be broken if lazykube exits while writing.
+	tmpFilePath := collector.filePath + ".tmp"
+	if err := ioutil.WriteFile(tmpFilePath, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFilePath, collector.filePath)
+}
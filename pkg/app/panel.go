@@ -3,11 +3,14 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/TNK-Studio/lazykube/pkg/config"
 	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
 	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"github.com/TNK-Studio/lazykube/pkg/log"
 	"github.com/fatih/camelcase"
 	"github.com/jroimartin/gocui"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"strings"
 )
 
@@ -20,6 +23,10 @@ const (
 	optionViewName      = "option"
 	podViewName         = "pod"
 	serviceViewName     = "service"
+	helmViewName        = "helm"
+	cronJobViewName     = "cronJob"
+	storageViewName     = "storage"
+	ingressViewName     = "ingress"
 )
 
 var (
@@ -37,6 +44,14 @@ var (
 			toNavigation,
 			nextFunctionView,
 			changeContext,
+			persistContextAction,
+			applyManifestAction,
+			addHelmPanelAction,
+			addCronJobPanelAction,
+			addStoragePanelAction,
+			addIngressPanelAction,
+			browseCRDsAction,
+			exploreAPIResourcesAction,
 			newMoreActions(moreActionsMap[clusterInfoViewName]),
 		}),
 		OnFocus: func(gui *guilib.Gui, view *guilib.View) error {
@@ -48,6 +63,7 @@ var (
 	Deployment = &guilib.View{
 		Name:                 deploymentViewName,
 		Title:                "Deployments",
+		TitleFunc:            resourcePanelTitle,
 		FgColor:              gocui.ColorDefault,
 		ZIndex:               zIndexOfFunctionView(deploymentViewName),
 		Clickable:            true,
@@ -75,8 +91,10 @@ var (
 			nextLine,
 			copySelectedLine,
 			filterResource,
+			toggleAllNamespacesAction,
 			editResourceAction,
 			newConfirmDialogAction(deploymentViewName, rolloutRestartAction),
+			selectCompareContextsAction,
 			newMoreActions(moreActionsMap[deploymentViewName]),
 		}),
 	}
@@ -123,6 +141,7 @@ var (
 		Name:       detailViewName,
 		Wrap:       true,
 		Title:      "",
+		TitleFunc:  detailTitle,
 		Clickable:  true,
 		OnRender:   detailRender,
 		Highlight:  true,
@@ -146,11 +165,8 @@ var (
 				Keys: keyMap[detailArrowUp],
 				Handler: func(gui *guilib.Gui, view *guilib.View) error {
 					_, oy := view.Origin()
-					if oy == 0 {
-						err := gui.FocusView(navigationViewName, false)
-						if err != nil {
-							return err
-						}
+					if oy == 0 && !isLogNavigation(gui, view) {
+						return gui.FocusView(navigationViewName, false)
 					}
 					return scrollUpHandler(gui, view)
 				},
@@ -165,6 +181,13 @@ var (
 			changePodLogsContainerAction,
 			tailLogsAction,
 			scrollLogsAction,
+			selectLogRangeAction,
+			includeLogsAction,
+			excludeLogsAction,
+			toggleLogFilterAction,
+			toggleRawLogsAction,
+			saveToFileAction,
+			switchConfigYAMLModeAction,
 			newMoreActions(moreActionsMap[detailViewName]),
 		}),
 	}
@@ -212,6 +235,10 @@ var (
 			}
 			return nil
 		},
+		OnFocusLost: func(gui *guilib.Gui, view *guilib.View) error {
+			addRecentNamespace(kubecli.Cli.Namespace())
+			return nil
+		},
 		FgColor: gocui.ColorDefault,
 		DimensionFunc: guilib.BeneathView(
 			aboveViewNameFunc,
@@ -225,6 +252,9 @@ var (
 			nextLine,
 			copySelectedLine,
 			filterResource,
+			toggleAllNamespacesAction,
+			favoriteNamespaceAction,
+			onlyFavoriteNamespacesAction,
 			editResourceAction,
 			newMoreActions(moreActionsMap[namespaceViewName]),
 		}),
@@ -244,6 +274,7 @@ var (
 	Pod = &guilib.View{
 		Name:                 podViewName,
 		Title:                "Pods",
+		TitleFunc:            resourcePanelTitle,
 		ZIndex:               zIndexOfFunctionView(deploymentViewName),
 		Clickable:            true,
 		OnRender:             namespaceResourceListRender("pods"),
@@ -262,27 +293,8 @@ var (
 			reactiveHeight,
 			migrateTopFunc,
 		),
-		LowerRightPointXFunc: func(gui *guilib.Gui, view *guilib.View) int {
-			if resizeableViews[len(resizeableViews)-1] == view.Name {
-				return leftSideWidth(gui.MaxWidth())
-			}
-
-			_, _, x1, _ := view.DimensionFunc(gui, view)
-			return x1
-		},
-		LowerRightPointYFunc: func(gui *guilib.Gui, view *guilib.View) int {
-			_, y0, _, y1 := view.DimensionFunc(gui, view)
-
-			if resizeableViews[len(resizeableViews)-1] == view.Name {
-				height := gui.MaxHeight() - 2
-				if height < y0+1 {
-					return y0 + 1
-				}
-
-				return height
-			}
-			return y1
-		},
+		LowerRightPointXFunc: functionPanelLowerRightPointX,
+		LowerRightPointYFunc: functionPanelLowerRightPointY,
 		Actions: guilib.ToActionInterfaceArr([]*guilib.Action{
 			toNavigation,
 			nextFunctionView,
@@ -290,6 +302,7 @@ var (
 			nextLine,
 			copySelectedLine,
 			filterResource,
+			toggleAllNamespacesAction,
 			editResourceAction,
 			containerExecCommandAction,
 			runPodAction,
@@ -300,6 +313,7 @@ var (
 	Service = &guilib.View{
 		Name:                 serviceViewName,
 		Title:                "Services",
+		TitleFunc:            resourcePanelTitle,
 		ZIndex:               zIndexOfFunctionView(deploymentViewName),
 		Clickable:            true,
 		OnRender:             resourceListRender,
@@ -324,6 +338,7 @@ var (
 			nextLine,
 			copySelectedLine,
 			filterResource,
+			toggleAllNamespacesAction,
 			editResourceAction,
 			newMoreActions(moreActionsMap[namespaceViewName]),
 		}),
@@ -334,6 +349,9 @@ var (
 		serviceViewName:    serviceResource,
 		deploymentViewName: deploymentResource,
 		podViewName:        podResource,
+		cronJobViewName:    cronJobResource,
+		storageViewName:    persistentVolumeClaimResource,
+		ingressViewName:    ingressResource,
 	}
 
 	restartableResource = []string{"deployments", "statefulsets", "daemonsets"}
@@ -341,15 +359,39 @@ var (
 	logAbleResource = []string{"deployment", "statefulset", "daemonset", "service", "pod"}
 )
 
+func functionPanelLowerRightPointX(gui *guilib.Gui, view *guilib.View) int {
+	if resizeableViews[len(resizeableViews)-1] == view.Name {
+		return leftSideWidth(gui.MaxWidth())
+	}
+
+	_, _, x1, _ := view.DimensionFunc(gui, view)
+	return x1
+}
+
+func functionPanelLowerRightPointY(gui *guilib.Gui, view *guilib.View) int {
+	_, y0, _, y1 := view.DimensionFunc(gui, view)
+
+	if resizeableViews[len(resizeableViews)-1] == view.Name {
+		height := gui.MaxHeight() - 2
+		if height < y0+1 {
+			return y0 + 1
+		}
+
+		return height
+	}
+	return y1
+}
+
 func getViewResourceName(viewName string) string {
 	return viewNameResourceMap[viewName]
 }
 
-func newCustomResourcePanel(resource string) *guilib.View {
+func newCustomResourcePanel(resource string, crd *kubecli.CustomResourceDefinition) *guilib.View {
 	viewName := resourceViewName(resource)
 	customResourcePanel := &guilib.View{
 		Name:                 resourceViewName(resource),
 		Title:                resourceViewTitle(resource),
+		TitleFunc:            resourcePanelTitle,
 		ZIndex:               zIndexOfFunctionView(viewName),
 		Clickable:            true,
 		OnRender:             resourceListRender,
@@ -367,37 +409,24 @@ func newCustomResourcePanel(resource string) *guilib.View {
 			reactiveHeight,
 			migrateTopFunc,
 		),
-		LowerRightPointXFunc: func(gui *guilib.Gui, view *guilib.View) int {
-			if resizeableViews[len(resizeableViews)-1] == view.Name {
-				return leftSideWidth(gui.MaxWidth())
-			}
-
-			_, _, x1, _ := view.DimensionFunc(gui, view)
-			return x1
-		},
-		LowerRightPointYFunc: func(gui *guilib.Gui, view *guilib.View) int {
-			_, y0, _, y1 := view.DimensionFunc(gui, view)
-
-			if resizeableViews[len(resizeableViews)-1] == view.Name {
-				height := gui.MaxHeight() - 2
-				if height < y0+1 {
-					return y0 + 1
-				}
-
-				return height
-			}
-			return y1
-		},
+		LowerRightPointXFunc: functionPanelLowerRightPointX,
+		LowerRightPointYFunc: functionPanelLowerRightPointY,
 		Actions: guilib.ToActionInterfaceArr([]*guilib.Action{
 			toNavigation,
 			nextFunctionView,
 			previousLine,
 			nextLine,
 			filterResource,
+			toggleAllNamespacesAction,
 			editResourceAction,
 		}),
 	}
 
+	// Use additional printer columns of custom resource definition.
+	if crd != nil && len(crd.PrinterColumns) > 0 {
+		customResourcePanel.OnRender = customResourceListRender(crd)
+	}
+
 	customPanelMoreActions := []*moreAction{
 		// initialization loop
 		//addCustomResourcePanelMoreAction,
@@ -409,6 +438,7 @@ func newCustomResourcePanel(resource string) *guilib.View {
 			customPanelMoreActions,
 			&moreAction{
 				NeedSelectResource: true,
+				Permission:         rolloutRestartPermission,
 				Action:             *newConfirmDialogAction(customResourcePanel.Name, rolloutRestartAction),
 			},
 		)
@@ -419,23 +449,65 @@ func newCustomResourcePanel(resource string) *guilib.View {
 }
 
 func addCustomResourcePanel(gui *guilib.Gui, resource string) error {
-	var customResourcePanel *guilib.View
-	customResourcePanel, _ = gui.GetView(resourceViewName(resource))
-	if customResourcePanel != nil {
+	if customResourcePanelExisted(gui, resource) {
 		return nil
 	}
 
-	customResourcePanel = newCustomResourcePanel(resource)
-	viewNameResourceMap[customResourcePanel.Name] = resource
+	crd, err := kubecli.Cli.GetCustomResourceDefinition(resource)
+	if err != nil {
+		log.Logger.Warningf("addCustomResourcePanel - kubecli.Cli.GetCustomResourceDefinition('%s') error %s", resource, err)
+	}
+	return addCustomResourcePanelWithDefinition(gui, resource, crd)
+}
 
-	// Add to function views and resizeable views.
-	functionViews = append(functionViews, customResourcePanel.Name)
-	resizeableViews = append(resizeableViews, customResourcePanel.Name)
+// addCustomResourcePanels add panels of resources which are not added yet, custom resource definitions will be listed once.
+func addCustomResourcePanels(gui *guilib.Gui, resources []string) {
+	missing := make([]string, 0)
+	for _, resource := range resources {
+		if !customResourcePanelExisted(gui, resource) {
+			missing = append(missing, resource)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	crds, err := kubecli.Cli.ListCustomResourceDefinitions()
+	if err != nil {
+		log.Logger.Warningf("addCustomResourcePanels - kubecli.Cli.ListCustomResourceDefinitions() error %s", err)
+	}
+	for _, resource := range missing {
+		crd := kubecli.FindCustomResourceDefinition(crds, resource)
+		// Migrate plural name of old config to the group qualified one.
+		if crd != nil && resource == crd.Plural && resource != crd.Resource() {
+			config.Conf.UserConfig.DeleteCustomResourcePanels(resource)
+			resource = crd.Resource()
+		}
+		if err := addCustomResourcePanelWithDefinition(gui, resource, crd); err != nil {
+			log.Logger.Warningf("addCustomResourcePanels - addCustomResourcePanelWithDefinition(gui, %s) error %s", resource, err)
+		}
+	}
+}
+
+func customResourcePanelExisted(gui *guilib.Gui, resource string) bool {
+	view, _ := gui.GetView(resourceViewName(resource))
+	return view != nil
+}
+
+func addCustomResourcePanelWithDefinition(gui *guilib.Gui, resource string, crd *kubecli.CustomResourceDefinition) error {
+	if customResourcePanelExisted(gui, resource) {
+		return nil
+	}
+
+	customResourcePanel := newCustomResourcePanel(resource, crd)
+	viewNameResourceMap[customResourcePanel.Name] = resource
 
 	// Add custom panel navigation.
-	viewNavigationMap[customResourcePanel.Name] = []string{navigationOptConfig, navigationOptDescribe}
+	viewNavigationMap[customResourcePanel.Name] = []string{navigationOptConfig, navigationOptDescribe, navigationOptExplain, navigationOptDrift}
 	detailRenderMap[navigationPath(customResourcePanel.Name, navigationOptConfig)] = clearBeforeRender(configRender)
 	detailRenderMap[navigationPath(customResourcePanel.Name, navigationOptDescribe)] = reRenderInterval(clearBeforeRender(describeRender), reRenderIntervalDuration)
+	detailRenderMap[navigationPath(customResourcePanel.Name, navigationOptExplain)] = clearBeforeRender(explainRender)
+	detailRenderMap[navigationPath(customResourcePanel.Name, navigationOptDrift)] = reRenderInterval(clearBeforeRender(driftRender), reRenderIntervalDuration)
 
 	// Add pods and pods log navigation
 	if resourceRestartable(resource) {
@@ -452,14 +524,7 @@ func addCustomResourcePanel(gui *guilib.Gui, resource string) error {
 		reRenderIntervalDuration,
 	)
 
-	if err := resizePanelHeight(gui); err != nil {
-		return err
-	}
-	if err := gui.AddView(customResourcePanel); err != nil {
-		return err
-	}
-
-	if err := gui.FocusView(customResourcePanel.Name, false); err != nil {
+	if err := addFunctionPanel(gui, customResourcePanel); err != nil {
 		return err
 	}
 	config.Conf.UserConfig.AddCustomResourcePanels(resource)
@@ -474,6 +539,177 @@ func deleteCustomResourcePanel(gui *guilib.Gui, viewName string) error {
 		return nil
 	}
 
+	// Delete navigation options of namespace panel
+	for index, option := range viewNavigationMap[namespaceViewName] {
+		if option == customResourcePanel.Title {
+			viewNavigationMap[namespaceViewName] = append(
+				viewNavigationMap[namespaceViewName][:index],
+				viewNavigationMap[namespaceViewName][index+1:]...,
+			)
+		}
+	}
+
+	if err := deleteFunctionPanel(gui, customResourcePanel.Name); err != nil {
+		return err
+	}
+	config.Conf.UserConfig.DeleteCustomResourcePanels(getViewResourceName(customResourcePanel.Name))
+	config.Save()
+	return nil
+}
+
+// newFunctionPanel returns a function panel which can be added and deleted by user, actions are specific to the panel.
+func newFunctionPanel(name, title string, onRender guilib.ViewHandler, actions []*guilib.Action, moreActions []*moreAction) *guilib.View {
+	panelActions := append([]*guilib.Action{
+		toNavigation,
+		nextFunctionView,
+		previousLine,
+		nextLine,
+		toggleAllNamespacesAction,
+	}, actions...)
+	panelActions = append(
+		panelActions,
+		deleteFunctionPanelAction,
+		newMoreActions(append(moreActions, deleteFunctionPanelMoreAction)),
+	)
+
+	return &guilib.View{
+		Name:                 name,
+		Title:                title,
+		TitleFunc:            resourcePanelTitle,
+		ZIndex:               zIndexOfFunctionView(name),
+		Clickable:            true,
+		OnRender:             onRender,
+		OnSelectedLineChange: viewSelectedLineChangeHandler,
+		Highlight:            true,
+		SelFgColor:           gocui.ColorGreen,
+		OnFocus: func(gui *guilib.Gui, view *guilib.View) error {
+			if err := onFocusClearSelected(gui, view); err != nil {
+				return err
+			}
+			return nil
+		},
+		DimensionFunc: guilib.BeneathView(
+			aboveViewNameFunc,
+			reactiveHeight,
+			migrateTopFunc,
+		),
+		LowerRightPointXFunc: functionPanelLowerRightPointX,
+		LowerRightPointYFunc: functionPanelLowerRightPointY,
+		Actions:              guilib.ToActionInterfaceArr(panelActions),
+	}
+}
+
+// newOptionalPanel returns the optional function panel of view name, nil if it is unknown.
+func newOptionalPanel(name string) *guilib.View {
+	switch name {
+	case helmViewName:
+		return newFunctionPanel(
+			helmViewName,
+			"Helm Releases",
+			helmReleasesRender,
+			[]*guilib.Action{copySelectedLine, rollbackHelmReleaseAction},
+			[]*moreAction{copySelectedLineMoreAction, rollbackHelmReleaseMoreAction},
+		)
+	case cronJobViewName:
+		return newFunctionPanel(
+			cronJobViewName,
+			"CronJobs",
+			resourceListRender,
+			[]*guilib.Action{
+				filterResource,
+				editResourceAction,
+				newConfirmDialogAction(cronJobViewName, triggerCronJobAction),
+				newConfirmDialogAction(cronJobViewName, suspendCronJobAction),
+				newConfirmDialogAction(cronJobViewName, deleteFinishedJobsAction),
+			},
+			[]*moreAction{
+				editResourceMoreAction,
+				copySelectedLineMoreAction,
+				{
+					NeedSelectResource: true,
+					Permission:         createJobsPermission,
+					Action:             *newConfirmDialogAction(cronJobViewName, triggerCronJobAction),
+				},
+				{
+					NeedSelectResource: true,
+					Action:             *newConfirmDialogAction(cronJobViewName, suspendCronJobAction),
+				},
+				{
+					NeedSelectResource: true,
+					Permission:         deleteJobsPermission,
+					Action:             *newConfirmDialogAction(cronJobViewName, deleteFinishedJobsAction),
+				},
+			},
+		)
+	case storageViewName:
+		return newFunctionPanel(
+			storageViewName,
+			"Storage",
+			persistentVolumeClaimsRender,
+			[]*guilib.Action{filterResource, editResourceAction},
+			[]*moreAction{editResourceMoreAction, copySelectedLineMoreAction},
+		)
+	case ingressViewName:
+		return newFunctionPanel(
+			ingressViewName,
+			"Ingresses",
+			resourceListRender,
+			[]*guilib.Action{filterResource, editResourceAction},
+			[]*moreAction{editResourceMoreAction, copySelectedLineMoreAction},
+		)
+	}
+	return nil
+}
+
+func addOptionalPanel(gui *guilib.Gui, name string) error {
+	if panel, _ := gui.GetView(name); panel != nil {
+		return nil
+	}
+
+	panel := newOptionalPanel(name)
+	if panel == nil {
+		return fmt.Errorf("unknown panel '%s'", name)
+	}
+	if err := addFunctionPanel(gui, panel); err != nil {
+		return err
+	}
+	config.Conf.UserConfig.AddOptionalPanel(name)
+	config.Save()
+	return nil
+}
+
+func deleteOptionalPanel(gui *guilib.Gui, name string) error {
+	if panel, _ := gui.GetView(name); panel == nil {
+		return nil
+	}
+
+	if err := deleteFunctionPanel(gui, name); err != nil {
+		return err
+	}
+	config.Conf.UserConfig.DeleteOptionalPanel(name)
+	config.Save()
+	return nil
+}
+
+func addFunctionPanel(gui *guilib.Gui, panel *guilib.View) error {
+	// Add to function views and resizeable views.
+	functionViews = append(functionViews, panel.Name)
+	resizeableViews = append(resizeableViews, panel.Name)
+
+	if err := resizePanelHeight(gui); err != nil {
+		return err
+	}
+	if err := gui.AddView(panel); err != nil {
+		return err
+	}
+
+	if err := gui.FocusView(panel.Name, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deleteFunctionPanel(gui *guilib.Gui, viewName string) error {
 	for index, eachViewName := range functionViews {
 		if eachViewName == viewName {
 			functionViews = append(functionViews[:index], functionViews[index+1:]...)
@@ -486,27 +722,15 @@ func deleteCustomResourcePanel(gui *guilib.Gui, viewName string) error {
 		}
 	}
 
-	// Delete navigation options of namespace panel
-	for index, option := range viewNavigationMap[namespaceViewName] {
-		if option == customResourcePanel.Title {
-			viewNavigationMap[namespaceViewName] = append(
-				viewNavigationMap[namespaceViewName][:index],
-				viewNavigationMap[namespaceViewName][index+1:]...,
-			)
-		}
-	}
-
 	if err := resizePanelHeight(gui); err != nil {
 		return err
 	}
-	if err := gui.DeleteView(customResourcePanel.Name); err != nil {
+	if err := gui.DeleteView(viewName); err != nil {
 		return err
 	}
 	if err := gui.FocusView(functionViews[0], false); err != nil {
 		return err
 	}
-	config.Conf.UserConfig.DeleteCustomResourcePanels(getViewResourceName(customResourcePanel.Name))
-	config.Save()
 	return nil
 }
 
@@ -531,9 +755,15 @@ func zIndexOfFunctionView(viewName string) int {
 	return i
 }
 
+// resourceRestartable check if resource can be rollout restarted, resource may be group qualified like "deployments.apps".
 func resourceRestartable(resource string) bool {
+	groupResource := schema.ParseGroupResource(resource)
+	if groupResource.Group != "" && groupResource.Group != "apps" && groupResource.Group != "extensions" {
+		return false
+	}
+
 	for _, restartable := range restartableResource {
-		if resource == restartable {
+		if groupResource.Resource == restartable {
 			return true
 		}
 	}
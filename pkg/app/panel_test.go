@@ -0,0 +1,21 @@
+package app
+
+import "testing"
+
+func TestResourceRestartable(t *testing.T) {
+	cases := map[string]bool{
+		"deployments":             true,
+		"deployments.apps":        true,
+		"statefulsets.apps":       true,
+		"daemonsets.apps":         true,
+		"daemonsets.extensions":   true,
+		"deployments.example.com": false,
+		"pods":                    false,
+		"cronjobs.batch":          false,
+	}
+	for resource, expected := range cases {
+		if restartable := resourceRestartable(resource); restartable != expected {
+			t.Errorf("resourceRestartable(%q) = %v, expected %v", resource, restartable, expected)
+		}
+	}
+}
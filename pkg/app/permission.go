@@ -0,0 +1,68 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"github.com/TNK-Studio/lazykube/pkg/log"
+)
+
+// permission RBAC permission which required by action.
+type permission struct {
+	verb        string
+	resource    string // Resource of the panel will be used if empty.
+	subresource string
+}
+
+var (
+	editPermission           = &permission{verb: "patch"}
+	rolloutRestartPermission = &permission{verb: "patch"}
+	execPermission           = &permission{verb: "create", resource: "pods", subresource: "exec"}
+	createJobsPermission     = &permission{verb: "create", resource: "jobs"}
+	deleteJobsPermission     = &permission{verb: "delete", resource: "jobs"}
+)
+
+// hasPermission checks permission on the resource of view, returns true if it can not be checked.
+func hasPermission(gui *guilib.Gui, view *guilib.View, perm *permission) (bool, string) {
+	resourceView := view
+	if view.Name == detailViewName || view.Name == navigationViewName {
+		resourceView = activeView
+	}
+	if resourceView == nil {
+		return true, ""
+	}
+
+	resource := perm.resource
+	if resource == "" {
+		resource = getViewResourceName(resourceView.Name)
+	}
+	if resource == "" {
+		return true, ""
+	}
+
+	namespace, _, err := getResourceNamespaceAndName(gui, resourceView)
+	if err != nil {
+		namespace = kubecli.Cli.Namespace()
+	}
+
+	allowed, err := kubecli.Cli.CanI(context.Background(), namespace, perm.verb, resource, perm.subresource)
+	if err != nil {
+		log.Logger.Warningf("hasPermission - kubecli.Cli.CanI('%s', '%s', '%s', '%s') error %s", namespace, perm.verb, resource, perm.subresource, err)
+		return true, ""
+	}
+
+	if perm.subresource != "" {
+		resource = fmt.Sprintf("%s/%s", resource, perm.subresource)
+	}
+	return allowed, fmt.Sprintf("Forbidden: you can not '%s' %s in namespace '%s'.", perm.verb, resource, namespace)
+}
+
+func permissionRequired(perm *permission, handler guilib.ViewHandler) guilib.ViewHandler {
+	return func(gui *guilib.Gui, view *guilib.View) error {
+		if allowed, message := hasPermission(gui, view, perm); !allowed {
+			return setDetailRenderFunc(gui, contentRender(message))
+		}
+		return handler(gui, view)
+	}
+}
@@ -3,14 +3,17 @@ package app
 import (
 	"errors"
 	"fmt"
+	"github.com/TNK-Studio/lazykube/pkg/config"
 	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
 	"github.com/TNK-Studio/lazykube/pkg/kubecli"
 	"github.com/TNK-Studio/lazykube/pkg/log"
 	"github.com/TNK-Studio/lazykube/pkg/utils"
 	"github.com/gookit/color"
+	"hash/fnv"
 	"io"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -18,12 +21,20 @@ import (
 const (
 	optSeparator       = "   "
 	navigationPathJoin = " + "
-	logsTail           = "500"
+	allNamespacesMode  = "all namespaces"
+	logTimeFormat      = "01-02 15:04:05.000"
+	namespaceColumn    = "NAMESPACE"
+
+	noFavoriteNamespaces = "No favorite namespaces, press '*' on a namespace to add."
 
 	namespaceResource  = "namespace"
 	serviceResource    = "service"
 	deploymentResource = "deployment"
 	podResource        = "pod"
+	cronJobResource    = "cronjob"
+
+	persistentVolumeClaimResource = "persistentvolumeclaim"
+	ingressResource               = "ingress"
 
 	reRenderIntervalDuration = 3 * time.Second
 )
@@ -35,46 +46,107 @@ var (
 	navigationIndex     int
 	activeNavigationOpt string
 
-	navigationOptNodes       = "Nodes"
-	navigationOptTopNodes    = "Top Nodes"
-	navigationOptDeployments = "Deployments"
-	navigationOptPods        = "Pods"
-	navigationOptPodsLog     = "Pods Log"
-	navigationOptTopPods     = "Top Pods"
-	navigationOptServices    = "Services"
-	navigationOptConfig      = "Config"
-	navigationOptDescribe    = "Describe"
-	navigationOptTop         = "Top"
-	navigationOptLog         = "Log"
+	podLogColors = []color.Color{
+		color.Cyan,
+		color.Green,
+		color.Yellow,
+		color.Magenta,
+		color.Blue,
+		color.LightCyan,
+		color.LightGreen,
+		color.LightYellow,
+		color.LightMagenta,
+		color.LightBlue,
+	}
+
+	navigationOptNodes           = "Nodes"
+	navigationOptTopNodes        = "Top Nodes"
+	navigationOptDeployments     = "Deployments"
+	navigationOptPods            = "Pods"
+	navigationOptPodsLog         = "Pods Log"
+	navigationOptTopPods         = "Top Pods"
+	navigationOptServices        = "Services"
+	navigationOptConfig          = "Config"
+	navigationOptDescribe        = "Describe"
+	navigationOptTop             = "Top"
+	navigationOptLog             = "Log"
+	navigationOptDrift           = "Drift"
+	navigationOptValues          = "Values"
+	navigationOptManifest        = "Manifest"
+	navigationOptNotes           = "Notes"
+	navigationOptHistory         = "History"
+	navigationOptJobs            = "Jobs"
+	navigationOptHPA             = "Autoscaling"
+	navigationOptCompare         = "Compare"
+	navigationOptCrash           = "Crash"
+	navigationOptStorage         = "Storage"
+	navigationOptURLs            = "URLs"
+	navigationOptEndpoints       = "Endpoints"
+	navigationOptRules           = "Rules"
+	navigationOptPermissions     = "Permissions"
+	navigationOptQuotas          = "Quotas"
+	navigationOptNetworkPolicies = "Network Policies"
+	navigationOptExplain         = "Explain"
 
 	viewNavigationMap = map[string][]string{
-		clusterInfoViewName: {navigationOptNodes, navigationOptTopNodes},
-		namespaceViewName:   {navigationOptConfig, navigationOptServices, navigationOptDeployments, navigationOptPods},
-		serviceViewName:     {navigationOptConfig, navigationOptPods, navigationOptPodsLog, navigationOptTopPods},
-		deploymentViewName:  {navigationOptConfig, navigationOptDescribe, navigationOptPods, navigationOptPodsLog, navigationOptTopPods},
-		podViewName:         {navigationOptLog, navigationOptConfig, navigationOptDescribe, navigationOptTop},
+		clusterInfoViewName: {navigationOptNodes, navigationOptTopNodes, navigationOptStorage, navigationOptURLs},
+		namespaceViewName:   {navigationOptConfig, navigationOptServices, navigationOptDeployments, navigationOptPods, navigationOptTopPods, navigationOptQuotas, navigationOptPermissions},
+		serviceViewName:     {navigationOptConfig, navigationOptEndpoints, navigationOptPods, navigationOptPodsLog, navigationOptTopPods, navigationOptDrift},
+		deploymentViewName:  {navigationOptConfig, navigationOptDescribe, navigationOptPods, navigationOptPodsLog, navigationOptTopPods, navigationOptHPA, navigationOptCompare, navigationOptDrift},
+		podViewName:         {navigationOptLog, navigationOptCrash, navigationOptConfig, navigationOptDescribe, navigationOptTop, navigationOptNetworkPolicies, navigationOptDrift},
+		helmViewName:        {navigationOptValues, navigationOptManifest, navigationOptNotes, navigationOptHistory},
+		cronJobViewName:     {navigationOptJobs, navigationOptConfig, navigationOptDescribe, navigationOptDrift},
+		storageViewName:     {navigationOptPods, navigationOptConfig, navigationOptDescribe},
+		ingressViewName:     {navigationOptRules, navigationOptConfig, navigationOptDescribe},
 	}
 
 	detailRenderMap = map[string]guilib.ViewHandler{
 		navigationPath(clusterInfoViewName, navigationOptNodes):     reRenderInterval(clearBeforeRender(clusterNodesRender), reRenderIntervalDuration),
 		navigationPath(clusterInfoViewName, navigationOptTopNodes):  reRenderInterval(clearBeforeRender(topNodesRender), reRenderIntervalDuration),
+		navigationPath(clusterInfoViewName, navigationOptStorage):   reRenderInterval(clearBeforeRender(clusterStorageRender), reRenderIntervalDuration),
+		navigationPath(clusterInfoViewName, navigationOptURLs):      reRenderInterval(clearBeforeRender(clusterURLsRender), reRenderIntervalDuration),
 		navigationPath(namespaceViewName, navigationOptDeployments): reRenderInterval(clearBeforeRender(namespaceResourceListRender("deployments")), reRenderIntervalDuration),
 		navigationPath(namespaceViewName, navigationOptPods):        reRenderInterval(clearBeforeRender(namespaceResourceListRender("pods")), reRenderIntervalDuration),
 		navigationPath(namespaceViewName, navigationOptServices):    reRenderInterval(clearBeforeRender(namespaceResourceListRender("services")), reRenderIntervalDuration),
+		navigationPath(namespaceViewName, navigationOptTopPods):     reRenderInterval(clearBeforeRender(namespaceTopPodsRender), reRenderIntervalDuration),
+		navigationPath(namespaceViewName, navigationOptQuotas):      reRenderInterval(clearBeforeRender(namespaceQuotasRender), reRenderIntervalDuration),
+		navigationPath(namespaceViewName, navigationOptPermissions): reRenderInterval(clearBeforeRender(namespacePermissionsRender), reRenderIntervalDuration),
 		navigationPath(namespaceViewName, navigationOptConfig):      reRenderInterval(clearBeforeRender(configRender), reRenderIntervalDuration),
 		navigationPath(serviceViewName, navigationOptConfig):        reRenderInterval(clearBeforeRender(configRender), reRenderIntervalDuration),
+		navigationPath(serviceViewName, navigationOptEndpoints):     reRenderInterval(clearBeforeRender(serviceEndpointsRender), reRenderIntervalDuration),
 		navigationPath(serviceViewName, navigationOptPods):          reRenderInterval(clearBeforeRender(labelsPodsRender), reRenderIntervalDuration),
 		navigationPath(serviceViewName, navigationOptPodsLog):       reRenderInterval(podsLogsRender, reRenderIntervalDuration),
 		navigationPath(serviceViewName, navigationOptTopPods):       reRenderInterval(clearBeforeRender(topPodsRender), reRenderIntervalDuration),
+		navigationPath(serviceViewName, navigationOptDrift):         reRenderInterval(clearBeforeRender(driftRender), reRenderIntervalDuration),
 		navigationPath(deploymentViewName, navigationOptConfig):     reRenderInterval(clearBeforeRender(configRender), reRenderIntervalDuration),
 		navigationPath(deploymentViewName, navigationOptPods):       reRenderInterval(clearBeforeRender(labelsPodsRender), reRenderIntervalDuration),
 		navigationPath(deploymentViewName, navigationOptDescribe):   reRenderInterval(clearBeforeRender(describeRender), reRenderIntervalDuration),
 		navigationPath(deploymentViewName, navigationOptPodsLog):    reRenderInterval(podsLogsRender, reRenderIntervalDuration),
+		navigationPath(deploymentViewName, navigationOptHPA):        reRenderInterval(deploymentAutoscalingRender, reRenderIntervalDuration),
+		navigationPath(deploymentViewName, navigationOptCompare):    reRenderInterval(deploymentCompareRender, compareRenderIntervalDuration),
 		navigationPath(deploymentViewName, navigationOptTopPods):    reRenderInterval(clearBeforeRender(topPodsRender), reRenderIntervalDuration),
+		navigationPath(deploymentViewName, navigationOptDrift):      reRenderInterval(clearBeforeRender(driftRender), reRenderIntervalDuration),
 		navigationPath(podViewName, navigationOptConfig):            reRenderInterval(clearBeforeRender(configRender), reRenderIntervalDuration),
 		navigationPath(podViewName, navigationOptLog):               reRenderInterval(podLogsRender, reRenderIntervalDuration),
+		navigationPath(podViewName, navigationOptCrash):             reRenderInterval(clearBeforeRender(podCrashRender), reRenderIntervalDuration),
 		navigationPath(podViewName, navigationOptDescribe):          reRenderInterval(clearBeforeRender(describeRender), reRenderIntervalDuration),
 		navigationPath(podViewName, navigationOptTop):               reRenderInterval(podMetricsPlotRender, reRenderIntervalDuration),
+		navigationPath(podViewName, navigationOptNetworkPolicies):   reRenderInterval(clearBeforeRender(podNetworkPoliciesRender), reRenderIntervalDuration),
+		navigationPath(podViewName, navigationOptDrift):             reRenderInterval(clearBeforeRender(driftRender), reRenderIntervalDuration),
+		navigationPath(helmViewName, navigationOptValues):           reRenderInterval(clearBeforeRender(helmValuesRender), reRenderIntervalDuration),
+		navigationPath(helmViewName, navigationOptManifest):         reRenderInterval(clearBeforeRender(helmManifestRender), reRenderIntervalDuration),
+		navigationPath(helmViewName, navigationOptNotes):            reRenderInterval(clearBeforeRender(helmNotesRender), reRenderIntervalDuration),
+		navigationPath(helmViewName, navigationOptHistory):          reRenderInterval(clearBeforeRender(helmHistoryRender), reRenderIntervalDuration),
+		navigationPath(cronJobViewName, navigationOptJobs):          reRenderInterval(clearBeforeRender(cronJobJobsRender), reRenderIntervalDuration),
+		navigationPath(cronJobViewName, navigationOptConfig):        reRenderInterval(clearBeforeRender(configRender), reRenderIntervalDuration),
+		navigationPath(cronJobViewName, navigationOptDescribe):      reRenderInterval(clearBeforeRender(describeRender), reRenderIntervalDuration),
+		navigationPath(cronJobViewName, navigationOptDrift):         reRenderInterval(clearBeforeRender(driftRender), reRenderIntervalDuration),
+		navigationPath(storageViewName, navigationOptPods):          reRenderInterval(clearBeforeRender(persistentVolumeClaimPodsRender), reRenderIntervalDuration),
+		navigationPath(storageViewName, navigationOptConfig):        reRenderInterval(clearBeforeRender(configRender), reRenderIntervalDuration),
+		navigationPath(storageViewName, navigationOptDescribe):      reRenderInterval(clearBeforeRender(describeRender), reRenderIntervalDuration),
+		navigationPath(ingressViewName, navigationOptRules):         reRenderInterval(clearBeforeRender(ingressRulesRender), reRenderIntervalDuration),
+		navigationPath(ingressViewName, navigationOptConfig):        reRenderInterval(clearBeforeRender(configRender), reRenderIntervalDuration),
+		navigationPath(ingressViewName, navigationOptDescribe):      reRenderInterval(clearBeforeRender(describeRender), reRenderIntervalDuration),
 	}
 )
 
@@ -151,10 +223,35 @@ func clearDetailViewState(gui *guilib.Gui) {
 		return
 	}
 
+	if err := detailView.SetState(logRangeStateKey, nil, true); err != nil {
+		log.Logger.Warningf("clearDetailViewState - clear logRangeStateKey err %s", err)
+		return
+	}
+
+	if err := detailView.SetState(logScrollStateKey, nil, true); err != nil {
+		log.Logger.Warningf("clearDetailViewState - clear logScrollStateKey err %s", err)
+		return
+	}
+
+	if err := detailView.SetState(logCursorsStateKey, nil, true); err != nil {
+		log.Logger.Warningf("clearDetailViewState - clear logCursorsStateKey err %s", err)
+		return
+	}
+
 	if err := detailView.SetState(logContainerStateKey, nil, true); err != nil {
 		log.Logger.Warningf("clearDetailViewState - clear logContainerStateKey err %s", err)
 		return
 	}
+
+	if err := detailView.SetState(logPodStateKey, nil, true); err != nil {
+		log.Logger.Warningf("clearDetailViewState - clear logPodStateKey err %s", err)
+		return
+	}
+
+	if err := detailView.SetState(detailRenderFuncStateKey, nil, true); err != nil {
+		log.Logger.Warningf("clearDetailViewState - clear detailRenderFuncStateKey err %s", err)
+		return
+	}
 	_ = detailView.SetOrigin(0, 0)
 	_ = detailView.SetCursor(0, 0)
 	detailView.Clear()
@@ -259,7 +356,13 @@ func navigationOnClick(gui *guilib.Gui, view *guilib.View) error {
 func renderClusterInfo(_ *guilib.Gui, view *guilib.View) error {
 	view.Clear()
 	currentContext := kubecli.Cli.CurrentContext()
+	if config.Conf.UserConfig.PersistContext {
+		currentContext += " (persisted)"
+	}
 	currentNs := kubecli.Cli.Namespace()
+	if kubecli.Cli.AllNamespaces() {
+		currentNs = allNamespacesMode
+	}
 
 	if _, err := fmt.Fprintf(view, "Current Context: %s Namespace: %s", color.Green.Sprint(currentContext), color.Green.Sprint(currentNs)); err != nil {
 		return err
@@ -268,6 +371,16 @@ func renderClusterInfo(_ *guilib.Gui, view *guilib.View) error {
 }
 
 func detailRender(gui *guilib.Gui, view *guilib.View) error {
+	restoreLogsScroll(view)
+
+	// Temporary render function, it will be cleared when navigation changed.
+	if val, _ := view.GetState(detailRenderFuncStateKey); val != nil {
+		renderFunc, ok := val.(guilib.ViewHandler)
+		if ok {
+			return renderFunc(gui, view)
+		}
+	}
+
 	if activeView == nil {
 		return nil
 	}
@@ -278,6 +391,32 @@ func detailRender(gui *guilib.Gui, view *guilib.View) error {
 	return nil
 }
 
+func setDetailRenderFunc(gui *guilib.Gui, renderFunc guilib.ViewHandler) error {
+	detailView, err := gui.GetView(detailViewName)
+	if err != nil {
+		return err
+	}
+
+	detailView.Autoscroll = false
+	if err := detailView.SetOrigin(0, 0); err != nil {
+		return err
+	}
+	if err := detailView.SetState(detailRenderFuncStateKey, renderFunc, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func contentRender(content string) guilib.ViewHandler {
+	return func(_ *guilib.Gui, view *guilib.View) error {
+		view.Clear()
+		if _, err := fmt.Fprint(view, content); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
 func viewStreams(view *guilib.View) genericclioptions.IOStreams {
 	return genericclioptions.IOStreams{
 		In:     os.Stdin,
@@ -291,14 +430,19 @@ func clusterNodesRender(_ *guilib.Gui, view *guilib.View) error {
 	return nil
 }
 
-func topNodesRender(_ *guilib.Gui, view *guilib.View) error {
-	kubecli.Cli.TopNode(viewStreams(view), nil, "").Run()
-	view.ReRender()
-	return nil
-}
-
 func namespaceRender(_ *guilib.Gui, view *guilib.View) error {
 	view.Clear()
+	if config.Conf.UserConfig.OnlyFavoriteNamespaces {
+		favorites := contextNamespaces().Favorites
+		if len(favorites) == 0 {
+			_, err := fmt.Fprint(view, noFavoriteNamespaces)
+			return err
+		}
+		kubecli.Cli.Get(viewStreams(view), append([]string{namespaceResource}, favorites...)...).
+			SetFlag("ignore-not-found", "true").
+			Run()
+		return nil
+	}
 	kubecli.Cli.Get(viewStreams(view), namespaceResource).Run()
 	return nil
 }
@@ -306,7 +450,7 @@ func namespaceRender(_ *guilib.Gui, view *guilib.View) error {
 func namespaceResourceListRender(resource string) guilib.ViewHandler {
 	return func(gui *guilib.Gui, view *guilib.View) error {
 		view.Clear()
-		if kubecli.Cli.Namespace() == "" {
+		if kubecli.Cli.AllNamespaces() {
 			kubecli.Cli.Get(viewStreams(view), resource).SetFlag("all-namespaces", "true").SetFlag("output", "wide").Run()
 			return nil
 		}
@@ -318,7 +462,7 @@ func namespaceResourceListRender(resource string) guilib.ViewHandler {
 func resourceListRender(_ *guilib.Gui, view *guilib.View) error {
 	view.Clear()
 	resource := getViewResourceName(view.Name)
-	if kubecli.Cli.Namespace() == "" {
+	if kubecli.Cli.AllNamespaces() {
 		kubecli.Cli.Get(viewStreams(view), resource).SetFlag("all-namespaces", "true").Run()
 		return nil
 	}
@@ -326,6 +470,55 @@ func resourceListRender(_ *guilib.Gui, view *guilib.View) error {
 	return nil
 }
 
+// customResourceListRender render custom resources with additional printer columns of custom resource definition.
+func customResourceListRender(crd *kubecli.CustomResourceDefinition) guilib.ViewHandler {
+	columns := []string{"NAME:.metadata.name"}
+	for _, column := range crd.PrinterColumns {
+		if column.Priority > 0 {
+			continue
+		}
+		columns = append(columns, fmt.Sprintf("%s:%s", strings.ToUpper(column.Name), column.JSONPath))
+	}
+
+	return func(_ *guilib.Gui, view *guilib.View) error {
+		view.Clear()
+		if kubecli.Cli.AllNamespaces() && crd.Namespaced {
+			kubecli.Cli.Get(viewStreams(view), crd.Resource()).
+				SetFlag("all-namespaces", "true").
+				SetFlag("output", "custom-columns="+strings.Join(append([]string{"NAMESPACE:.metadata.namespace"}, columns...), ",")).
+				Run()
+			return nil
+		}
+		kubecli.Cli.Get(viewStreams(view), crd.Resource()).
+			SetFlag("output", "custom-columns="+strings.Join(columns, ",")).
+			Run()
+		return nil
+	}
+}
+
+func explainRender(_ *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	if activeView == nil {
+		return nil
+	}
+
+	resource := getViewResourceName(activeView.Name)
+	if resource == "" {
+		return nil
+	}
+
+	kubecli.Cli.Explain(viewStreams(view), resource).SetFlag("recursive", "true").Run()
+	return nil
+}
+
+// resourcePanelTitle shows namespace mode in the title of resource panel.
+func resourcePanelTitle(_ *guilib.Gui, view *guilib.View) string {
+	if kubecli.Cli.AllNamespaces() {
+		return fmt.Sprintf("%s (%s)", view.Title, allNamespacesMode)
+	}
+	return view.Title
+}
+
 func showPleaseSelected(view io.Writer, name string) {
 	_, err := fmt.Fprintf(view, "Please select a %s.\n ", name)
 	if err != nil {
@@ -345,8 +538,7 @@ func namespaceConfigRender(gui *guilib.Gui, view *guilib.View) error {
 		return nil
 	}
 
-	kubecli.Cli.Get(viewStreams(view), "namespaces", namespace).SetFlag("output", "yaml").Run()
-	return nil
+	return resourceYAMLRender(view, "", "namespaces", namespace)
 }
 
 func configRender(gui *guilib.Gui, view *guilib.View) error {
@@ -379,10 +571,39 @@ func configRender(gui *guilib.Gui, view *guilib.View) error {
 		return err
 	}
 
-	cli(namespace).Get(viewStreams(view), resource, resourceName).SetFlag("output", "yaml").Run()
+	return resourceYAMLRender(view, namespace, resource, resourceName)
+}
+
+func resourceYAMLRender(view *guilib.View, namespace, resource, resourceName string) error {
+	var content string
+	var err error
+	switch getConfigYAMLMode(view) {
+	case config.ConfigYAMLModeClean:
+		content, err = cli(namespace).GetCleanYAML(resource, resourceName)
+	case config.ConfigYAMLModeStatus:
+		content, err = cli(namespace).GetStatusYAML(resource, resourceName)
+	default:
+		stream := newStream()
+		cli(namespace).Get(stream, resource, resourceName).SetFlag("output", "yaml").Run()
+		content = streamToString(stream)
+	}
+	if err != nil {
+		content = err.Error()
+	}
+
+	if _, err := fmt.Fprint(view, colorfulYAML(content)); err != nil {
+		return err
+	}
 	return nil
 }
 
+func getConfigYAMLMode(view *guilib.View) string {
+	if val, _ := view.GetState(configYAMLModeStateKey); val != nil {
+		return val.(string)
+	}
+	return config.Conf.GuiConfig.GetConfigYAMLMode()
+}
+
 func describeRender(gui *guilib.Gui, view *guilib.View) error {
 	view.Clear()
 	if activeView == nil {
@@ -414,6 +635,64 @@ func describeRender(gui *guilib.Gui, view *guilib.View) error {
 	return nil
 }
 
+func driftRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	if activeView == nil {
+		return nil
+	}
+
+	resource := getViewResourceName(activeView.Name)
+	if resource == "" {
+		return nil
+	}
+
+	namespace, resourceName, err := getResourceNamespaceAndName(gui, activeView)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			showPleaseSelected(view, resource)
+			return nil
+		}
+		return err
+	}
+
+	lastApplied, live, err := cli(namespace).GetLastAppliedDrift(resource, resourceName)
+	if err != nil {
+		if _, err := fmt.Fprint(view, err); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	diff := getDriftDiff(view, lastApplied, live)
+	if diff == "" {
+		diff = noDifferences
+	}
+
+	if _, err := fmt.Fprint(view, colorfulDiff(diff)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// driftDiff diff of last applied and live YAML, it is only computed again when one of them is changed.
+type driftDiff struct {
+	lastApplied string
+	live        string
+	diff        string
+}
+
+func getDriftDiff(view *guilib.View, lastApplied, live string) string {
+	if val, _ := view.GetState(driftDiffStateKey); val != nil {
+		if cached, ok := val.(*driftDiff); ok && cached.lastApplied == lastApplied && cached.live == live {
+			return cached.diff
+		}
+	}
+
+	diff := utils.UnifiedDiff("last-applied", "live", lastApplied, live, 3)
+	_ = view.SetState(driftDiffStateKey, &driftDiff{lastApplied: lastApplied, live: live, diff: diff}, false)
+	return diff
+}
+
 func onFocusClearSelected(gui *guilib.Gui, view *guilib.View) error {
 	for _, functionViewName := range functionViews {
 		if functionViewName == view.Name || functionViewName == namespaceViewName {
@@ -421,7 +700,7 @@ func onFocusClearSelected(gui *guilib.Gui, view *guilib.View) error {
 		}
 		functionView, err := gui.GetView(functionViewName)
 		if err != nil {
-			log.Logger.Warningf("onFocusClearSelected - view name %s gui.GetView(\"%s\") error %s", view.Name, functionView, err)
+			log.Logger.Warningf("onFocusClearSelected - view name %s gui.GetView(\"%s\") error %s", view.Name, functionViewName, err)
 			continue
 		}
 		if err := functionView.SetOrigin(0, 0); err != nil {
@@ -449,13 +728,8 @@ func podLogsRender(gui *guilib.Gui, view *guilib.View) error {
 		return nil
 	}
 
-	podView, err := gui.GetView(podViewName)
-	if err != nil {
-		return err
-	}
-
 	resource := "pod"
-	namespace, resourceName, err := getResourceNamespaceAndName(gui, podView)
+	namespace, resourceName, err := getLogPodNamespaceAndName(gui, view)
 	if err != nil {
 		if errors.Is(err, noResourceSelectedErr) {
 			showPleaseSelected(view, resource)
@@ -482,9 +756,9 @@ func podLogsRender(gui *guilib.Gui, view *guilib.View) error {
 		logContainer = val.(string)
 	}
 
+	streams := newStream()
 	cmd := cli(namespace).
-		Logs(viewStreams(view), resourceName).
-		SetFlag("tail", logsTail).
+		Logs(streams, resourceName).
 		SetFlag("prefix", "true")
 
 	if logContainer == "" {
@@ -493,11 +767,18 @@ func podLogsRender(gui *guilib.Gui, view *guilib.View) error {
 		cmd.SetFlag("container", logContainer)
 	}
 
-	if hasSince {
+	logRange := getLogRange(view)
+	switch {
+	case hasSince:
 		cmd.SetFlag("since-time", since.Format(time.RFC3339))
+	case !logRange.Start().IsZero():
+		cmd.SetFlag("since-time", logRange.Start().Format(time.RFC3339))
+	default:
+		cmd.SetFlag("tail", strconv.FormatInt(logRange.Tail, 10))
 	}
 
 	cmd.Run()
+	writeLogLines(view, strings.Split(streamToString(streams), "\n"))
 
 	if err := view.SetState(logSinceTimeStateKey, time.Now(), true); err != nil {
 		return err
@@ -506,34 +787,87 @@ func podLogsRender(gui *guilib.Gui, view *guilib.View) error {
 	view.ReRender()
 	return nil
 }
+
+// getLogPodNamespaceAndName returns the pod which specified by logPodStateKey, or the selected pod of pod panel.
+func getLogPodNamespaceAndName(gui *guilib.Gui, view *guilib.View) (string, string, error) {
+	if val, _ := view.GetState(logPodStateKey); val != nil {
+		namespacedName := strings.SplitN(val.(string), "/", 2)
+		return namespacedName[0], namespacedName[1], nil
+	}
+
+	podView, err := gui.GetView(podViewName)
+	if err != nil {
+		return "", "", err
+	}
+	return getResourceNamespaceAndName(gui, podView)
+}
+
 func podsLogsRender(gui *guilib.Gui, view *guilib.View) error {
 	// Todo: Fix chinese character of logs.
 	if err := podsSelectorRenderHelper(func(namespace string, labelsArr []string) error {
-		var since time.Time
-		var hasSince bool
-		val, _ := view.GetState(logSinceTimeStateKey)
-		if val != nil {
-			hasSince = true
-			since = val.(time.Time)
+		cursors := getLogCursors(view)
+
+		// Note: Pods are listed on every render, so that new pods of a rollout will be followed.
+		pods, err := cli(namespace).ListPodsBySelector(namespace, strings.Join(labelsArr, ","))
+		if err != nil {
+			_, err := fmt.Fprintln(view, err)
+			return err
 		}
 
-		streams := newStream()
-		cmd := kubecli.Cli.WithNamespace(namespace).Logs(streams)
-		cmd.SetFlag("selector", strings.Join(labelsArr, ",")).
-			SetFlag("all-containers", "true").
-			SetFlag("tail", logsTail).
-			SetFlag("prefix", "true")
+		// Logs in the selected range are fetched at first, then logs since the last line of each container.
+		logRange := getLogRange(view)
+		start := logRange.Start()
+		lines, errs, err := cli(namespace).MergedLogs(namespace, pods, func(pod, container string) time.Time {
+			if cursor, ok := cursors[containerLogKey(pod, container)]; ok && !cursor.Time.IsZero() {
+				return cursor.Time
+			}
+			return start
+		}, logRange.Tail, kubecli.FollowLogsTimeout)
+		if err != nil {
+			_, err := fmt.Fprintln(view, err)
+			return err
+		}
 
-		if hasSince {
-			cmd.SetFlag("since-time", since.Format(time.RFC3339))
+		// Errors are printed once until they are changed, containers which are not started yet fail on every render.
+		failed := make(map[string]bool)
+		for _, logsErr := range errs {
+			key := containerLogKey(logsErr.Pod, logsErr.Container)
+			failed[key] = true
+			cursor := cursors.Get(key)
+			if cursor.Err == logsErr.Err.Error() {
+				continue
+			}
+			cursor.Err = logsErr.Err.Error()
+			fmt.Fprintln(view, color.Red.Sprint(logsErr.Error()))
+		}
+		for key, cursor := range cursors {
+			if !failed[key] {
+				cursor.Err = ""
+			}
 		}
 
-		cmd.Run()
+		filter := getLogFilter(view)
+		for _, line := range lines {
+			// Logs since the second of the last line are fetched again, skip the printed ones.
+			if !cursors.Get(containerLogKey(line.Pod, line.Container)).Add(line) {
+				continue
+			}
 
-		if err := view.SetState(logSinceTimeStateKey, time.Now(), true); err != nil {
+			if !filter.Match(fmt.Sprintf("[%s/%s] %s", line.Pod, line.Container, line.Message)) {
+				continue
+			}
+			fmt.Fprintf(
+				view,
+				"%s %s %s\n",
+				color.Gray.Sprint(line.Time.Local().Format(logTimeFormat)),
+				podLogColor(line.Pod).Sprintf("[%s/%s]", line.Pod, line.Container),
+				filter.Format(line.Message),
+			)
+		}
+
+		if err := view.SetState(logCursorsStateKey, cursors, true); err != nil {
 			return err
 		}
-		streamCopyTo(streams, view)
 		view.ReRender()
 		return nil
 	})(gui, view); err != nil {
@@ -542,6 +876,13 @@ func podsLogsRender(gui *guilib.Gui, view *guilib.View) error {
 	return nil
 }
 
+// podLogColor returns a stable color of pod.
+func podLogColor(pod string) color.Color {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(pod))
+	return podLogColors[hash.Sum32()%uint32(len(podLogColors))]
+}
+
 func labelsPodsRender(gui *guilib.Gui, view *guilib.View) error {
 	view.Clear()
 	if err := podsSelectorRenderHelper(func(namespace string, labelsArr []string) error {
@@ -557,20 +898,6 @@ func labelsPodsRender(gui *guilib.Gui, view *guilib.View) error {
 	return nil
 }
 
-func topPodsRender(gui *guilib.Gui, view *guilib.View) error {
-	view.Clear()
-	if err := podsSelectorRenderHelper(func(namespace string, labelsArr []string) error {
-		cmd := kubecli.Cli.WithNamespace(namespace).TopPod(viewStreams(view), nil)
-		cmd.SetFlag("selector", strings.Join(labelsArr, ","))
-		cmd.Run()
-		view.ReRender()
-		return nil
-	})(gui, view); err != nil {
-		return err
-	}
-	return nil
-}
-
 //nolint:funlen
 //nolint:funlen
 //nolint:funlen
@@ -0,0 +1,182 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"github.com/TNK-Studio/lazykube/pkg/config"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"github.com/TNK-Studio/lazykube/pkg/utils"
+	"github.com/gookit/color"
+	"github.com/jroimartin/gocui"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	compareRenderIntervalDuration = 10 * time.Second
+	tableCellPadding              = 3
+)
+
+// deploymentComparison summaries of deployment across contexts, they are fetched in background so that
+// an unreachable context will not block rendering. It is only accessed in UI goroutine.
+type deploymentComparison struct {
+	key       string
+	summaries []*kubecli.DeploymentSummary
+	fetchedAt time.Time
+	fetching  bool
+}
+
+func getDeploymentComparison(view *guilib.View, key string) *deploymentComparison {
+	if val, _ := view.GetState(deploymentComparisonStateKey); val != nil {
+		if comparison, ok := val.(*deploymentComparison); ok && comparison.key == key {
+			return comparison
+		}
+	}
+
+	comparison := &deploymentComparison{key: key}
+	_ = view.SetState(deploymentComparisonStateKey, comparison, false)
+	return comparison
+}
+
+// fetch fetch summaries in background and render them when done.
+func (comparison *deploymentComparison) fetch(gui *guilib.Gui, view *guilib.View, contexts []string, namespace, name string) {
+	if comparison.fetching || time.Since(comparison.fetchedAt) < compareRenderIntervalDuration {
+		return
+	}
+
+	comparison.fetching = true
+	go func() {
+		summaries := kubecli.CompareDeployment(contexts, namespace, name)
+		gui.Update(func(*gocui.Gui) error {
+			comparison.summaries, comparison.fetchedAt, comparison.fetching = summaries, time.Now(), false
+			if err := clearLastRenderTime(gui, detailViewName); err != nil {
+				return err
+			}
+			view.ReRender()
+			return nil
+		})
+	}()
+}
+
+func deploymentCompareRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	deploymentView, err := gui.GetView(deploymentViewName)
+	if err != nil {
+		return err
+	}
+
+	namespace, name, err := getResourceNamespaceAndName(gui, deploymentView)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			showPleaseSelected(view, deploymentResource)
+			return nil
+		}
+		return err
+	}
+
+	contexts := compareContexts()
+	comparison := getDeploymentComparison(view, strings.Join(append([]string{namespace, name}, contexts...), "/"))
+	comparison.fetch(gui, view, contexts, namespace, name)
+
+	fmt.Fprintf(view, "Deployment '%s' in namespace '%s' across contexts:\n\n", name, namespace)
+	if comparison.summaries == nil {
+		fmt.Fprintln(view, "Loading...")
+		return nil
+	}
+	summaries := comparison.summaries
+
+	// Images which differ from current context will be highlighted.
+	baseImages := ""
+	if len(summaries) > 0 && summaries[0].Err == nil {
+		baseImages = strings.Join(summaries[0].Images, ",")
+	}
+
+	rows := [][]tableCell{
+		{{text: "CONTEXT"}, {text: "READY"}, {text: "UP-TO-DATE"}, {text: "AVAILABLE"}, {text: "STATUS"}, {text: "IMAGES"}},
+	}
+	for _, summary := range summaries {
+		if summary.Err != nil {
+			rows = append(rows, []tableCell{
+				{text: summary.Context}, {text: "-"}, {text: "-"}, {text: "-"},
+				{text: summary.Err.Error(), sprint: color.Red.Sprint}, {text: "-"},
+			})
+			continue
+		}
+
+		images := tableCell{text: strings.Join(summary.Images, ",")}
+		if images.text != baseImages {
+			images.sprint = color.Yellow.Sprint
+		}
+		status := tableCell{text: summary.Status}
+		if summary.Ready != summary.Replicas {
+			status.sprint = color.Yellow.Sprint
+		}
+		rows = append(rows, []tableCell{
+			{text: summary.Context},
+			{text: fmt.Sprintf("%d/%d", summary.Ready, summary.Replicas)},
+			{text: fmt.Sprint(summary.Updated)},
+			{text: fmt.Sprint(summary.Available)},
+			status,
+			images,
+		})
+	}
+	writeTable(view, rows)
+
+	if len(contexts) == 1 {
+		fmt.Fprintf(view, "\nPress '%s' on deployments panel to select contexts to compare.", utils.GetKey(keyMap[selectCompareContextsActionName][0]))
+	}
+	return nil
+}
+
+// tableCell cell of table which is coloured by sprint after padding.
+type tableCell struct {
+	text   string
+	sprint func(a ...interface{}) string
+}
+
+// writeTable write rows aligned like tabwriter, escape codes of colours are not counted in width unlike tabwriter.
+func writeTable(writer io.Writer, rows [][]tableCell) {
+	widths := make([]int, 0)
+	for _, row := range rows {
+		for index, cell := range row {
+			if index >= len(widths) {
+				widths = append(widths, 0)
+			}
+			if width := utf8.RuneCountInString(cell.text); width > widths[index] {
+				widths[index] = width
+			}
+		}
+	}
+
+	for _, row := range rows {
+		cells := make([]string, 0, len(row))
+		for index, cell := range row {
+			padding := ""
+			if index < len(row)-1 {
+				padding = strings.Repeat(" ", widths[index]-utf8.RuneCountInString(cell.text)+tableCellPadding)
+			}
+			text := cell.text
+			if cell.sprint != nil {
+				text = cell.sprint(text)
+			}
+			cells = append(cells, text+padding)
+		}
+		fmt.Fprintln(writer, strings.Join(cells, ""))
+	}
+}
+
+// compareContexts returns current context and the selected contexts to compare.
+func compareContexts() []string {
+	current := kubecli.Cli.CurrentContext()
+	contexts := []string{current}
+	existed := kubecli.Cli.ListContexts()
+	for _, ctx := range config.Conf.UserConfig.CompareContexts {
+		if ctx != current && utils.StringInSlice(ctx, existed) {
+			contexts = append(contexts, ctx)
+		}
+	}
+	return contexts
+}
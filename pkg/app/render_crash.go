@@ -0,0 +1,119 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/gookit/color"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"strings"
+	"time"
+)
+
+const (
+	crashLogsTail       = 50
+	crashTimeFormat     = "2006-01-02 15:04:05"
+	noTerminationRecord = "No previous termination."
+)
+
+func podCrashRender(gui *guilib.Gui, view *guilib.View) error {
+	podView, err := gui.GetView(podViewName)
+	if err != nil {
+		return err
+	}
+
+	namespace, name, err := getResourceNamespaceAndName(gui, podView)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			showPleaseSelected(view, podResource)
+			return nil
+		}
+		return err
+	}
+
+	pod, err := cli(namespace).GetPod(namespace, name)
+	if err != nil {
+		_, err := fmt.Fprint(view, err)
+		return err
+	}
+
+	statuses := append(append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		fmt.Fprintf(
+			view,
+			"Container: %s   Restarts: %s   State: %s\n",
+			color.Green.Sprint(status.Name),
+			restartCount(status.RestartCount),
+			containerStateString(status.State),
+		)
+
+		terminated := status.LastTerminationState.Terminated
+		if terminated == nil {
+			fmt.Fprintf(view, "  %s\n\n", noTerminationRecord)
+			continue
+		}
+
+		fmt.Fprintf(view, "  Last termination:\n")
+		fmt.Fprintf(view, "    Reason:    %s\n", terminationReason(terminated.Reason))
+		fmt.Fprintf(view, "    Exit code: %d", terminated.ExitCode)
+		if terminated.Signal != 0 {
+			fmt.Fprintf(view, "   Signal: %d", terminated.Signal)
+		}
+		fmt.Fprintln(view)
+		fmt.Fprintf(view, "    Started:   %s\n", crashTime(terminated.StartedAt.Time))
+		fmt.Fprintf(view, "    Finished:  %s", crashTime(terminated.FinishedAt.Time))
+		if !terminated.StartedAt.IsZero() && !terminated.FinishedAt.IsZero() {
+			fmt.Fprintf(view, "   (ran %s)", duration.HumanDuration(terminated.FinishedAt.Sub(terminated.StartedAt.Time)))
+		}
+		fmt.Fprintln(view)
+		if terminated.Message != "" {
+			fmt.Fprintf(view, "    Message:   %s\n", strings.TrimSpace(terminated.Message))
+		}
+
+		fmt.Fprintf(view, "  Previous logs (last %d lines):\n", crashLogsTail)
+		logs, err := cli(namespace).PreviousLogs(namespace, name, status.Name, crashLogsTail)
+		if err != nil {
+			fmt.Fprintf(view, "    %s\n\n", err)
+			continue
+		}
+		for _, line := range strings.Split(strings.TrimRight(logs, "\n"), "\n") {
+			fmt.Fprintf(view, "    %s\n", highlightLogLevel(line))
+		}
+		fmt.Fprintln(view)
+	}
+	return nil
+}
+
+func restartCount(count int32) string {
+	if count > 0 {
+		return color.Yellow.Sprint(count)
+	}
+	return fmt.Sprint(count)
+}
+
+func containerStateString(state v1.ContainerState) string {
+	switch {
+	case state.Running != nil:
+		return color.Green.Sprintf("Running since %s", crashTime(state.Running.StartedAt.Time))
+	case state.Waiting != nil:
+		return color.Yellow.Sprintf("Waiting (%s)", state.Waiting.Reason)
+	case state.Terminated != nil:
+		return color.Red.Sprintf("Terminated (%s, exit code %d)", state.Terminated.Reason, state.Terminated.ExitCode)
+	}
+	return "Unknown"
+}
+
+func terminationReason(reason string) string {
+	if reason == "Completed" {
+		return color.Green.Sprint(reason)
+	}
+	return color.Red.Sprint(reason)
+}
+
+func crashTime(t time.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return t.Local().Format(crashTimeFormat)
+}
@@ -0,0 +1,93 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"time"
+)
+
+const (
+	noJobsFound = "No jobs found."
+)
+
+func cronJobJobsRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	cronJobView, err := gui.GetView(cronJobViewName)
+	if err != nil {
+		return nil
+	}
+
+	namespace, name, err := getResourceNamespaceAndName(gui, cronJobView)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			showPleaseSelected(view, cronJobResource)
+			return nil
+		}
+		return err
+	}
+
+	jobs, err := kubecli.Cli.ListCronJobJobs(context.Background(), namespace, name)
+	if err != nil {
+		_, err := fmt.Fprint(view, err)
+		return err
+	}
+	if len(jobs) == 0 {
+		_, err := fmt.Fprint(view, noJobsFound)
+		return err
+	}
+
+	jobsPods, err := kubecli.Cli.ListJobsPods(context.Background(), namespace, jobs)
+	if err != nil {
+		_, err := fmt.Fprint(view, err)
+		return err
+	}
+
+	writer := newTabWriter(view)
+	fmt.Fprintln(writer, "JOB\tSTATUS\tCOMPLETIONS\tDURATION\tAGE")
+	for index := range jobs {
+		job := &jobs[index]
+		fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%s\t%s\n",
+			job.Name,
+			kubecli.JobStatus(job),
+			jobCompletions(job),
+			jobDuration(job),
+			duration.HumanDuration(time.Since(job.CreationTimestamp.Time)),
+		)
+
+		for _, pod := range jobsPods[job.Name] {
+			fmt.Fprintf(
+				writer,
+				"  └─ %s\t%s\t\t\t%s\n",
+				pod.Name,
+				pod.Status.Phase,
+				duration.HumanDuration(time.Since(pod.CreationTimestamp.Time)),
+			)
+		}
+	}
+	return writer.Flush()
+}
+
+func jobCompletions(job *batchv1.Job) string {
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	return fmt.Sprintf("%d/%d", job.Status.Succeeded, completions)
+}
+
+func jobDuration(job *batchv1.Job) string {
+	if job.Status.StartTime == nil {
+		return ""
+	}
+	if job.Status.CompletionTime == nil {
+		return duration.HumanDuration(time.Since(job.Status.StartTime.Time))
+	}
+	return duration.HumanDuration(job.Status.CompletionTime.Sub(job.Status.StartTime.Time))
+}
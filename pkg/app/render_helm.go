@@ -0,0 +1,154 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"io"
+	"text/tabwriter"
+)
+
+const (
+	helmRelease             = "helm release"
+	helmUpdatedTimeFormat   = "2006-01-02 15:04:05"
+	noHelmReleasesFound     = "No helm releases found."
+	noHelmReleaseNotesFound = "No notes found."
+)
+
+func helmReleasesRender(_ *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	namespace := kubecli.Cli.Namespace()
+	releases, err := kubecli.Cli.ListHelmReleases(context.Background(), namespace)
+	if err != nil {
+		_, err := fmt.Fprint(view, err)
+		return err
+	}
+
+	if len(releases) == 0 {
+		_, err := fmt.Fprint(view, noHelmReleasesFound)
+		return err
+	}
+
+	writer := newTabWriter(view)
+	header := "NAME\tREVISION\tSTATUS\tCHART\tAPP VERSION\tUPDATED"
+	if namespace == "" {
+		header = "NAMESPACE\t" + header
+	}
+	fmt.Fprintln(writer, header)
+	for _, release := range releases {
+		row := fmt.Sprintf(
+			"%s\t%d\t%s\t%s-%s\t%s\t%s",
+			release.Name,
+			release.Revision,
+			release.Status,
+			release.Chart,
+			release.ChartVersion,
+			release.AppVersion,
+			release.Updated.Local().Format(helmUpdatedTimeFormat),
+		)
+		if namespace == "" {
+			row = release.Namespace + "\t" + row
+		}
+		fmt.Fprintln(writer, row)
+	}
+	return writer.Flush()
+}
+
+func helmValuesRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	history, ok := selectedHelmReleaseHistory(gui, view)
+	if !ok {
+		return nil
+	}
+
+	values, err := kubecli.ToYAML(history[0].Values)
+	if err != nil {
+		values = err.Error()
+	}
+	_, err = fmt.Fprint(view, colorfulYAML(values))
+	return err
+}
+
+func helmManifestRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	history, ok := selectedHelmReleaseHistory(gui, view)
+	if !ok {
+		return nil
+	}
+
+	_, err := fmt.Fprint(view, colorfulYAML(history[0].Manifest))
+	return err
+}
+
+func helmNotesRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	history, ok := selectedHelmReleaseHistory(gui, view)
+	if !ok {
+		return nil
+	}
+
+	notes := history[0].Notes
+	if notes == "" {
+		notes = noHelmReleaseNotesFound
+	}
+	_, err := fmt.Fprint(view, notes)
+	return err
+}
+
+func helmHistoryRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	history, ok := selectedHelmReleaseHistory(gui, view)
+	if !ok {
+		return nil
+	}
+
+	writer := newTabWriter(view)
+	fmt.Fprintln(writer, "REVISION\tUPDATED\tSTATUS\tCHART\tAPP VERSION\tDESCRIPTION")
+	for _, release := range history {
+		fmt.Fprintf(
+			writer,
+			"%d\t%s\t%s\t%s-%s\t%s\t%s\n",
+			release.Revision,
+			release.Updated.Local().Format(helmUpdatedTimeFormat),
+			release.Status,
+			release.Chart,
+			release.ChartVersion,
+			release.AppVersion,
+			release.Description,
+		)
+	}
+	return writer.Flush()
+}
+
+// selectedHelmReleaseHistory returns revisions of the selected helm release, or writes the reason to view.
+func selectedHelmReleaseHistory(gui *guilib.Gui, view *guilib.View) ([]*kubecli.HelmRelease, bool) {
+	helmView, err := gui.GetView(helmViewName)
+	if err != nil {
+		return nil, false
+	}
+
+	namespace, name, err := getResourceNamespaceAndName(gui, helmView)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			showPleaseSelected(view, helmRelease)
+		}
+		return nil, false
+	}
+
+	history, err := kubecli.Cli.GetHelmReleaseHistory(context.Background(), namespace, name)
+	if err != nil {
+		fmt.Fprint(view, err)
+		return nil, false
+	}
+	if len(history) == 0 {
+		fmt.Fprintf(view, "Helm release '%s' not found.", name)
+		return nil, false
+	}
+	return history, true
+}
+
+func newTabWriter(output io.Writer) *tabwriter.Writer {
+	return tabwriter.NewWriter(output, 6, 4, 3, ' ', 0)
+}
@@ -0,0 +1,124 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"github.com/TNK-Studio/lazykube/pkg/utils"
+	"github.com/gookit/color"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"math"
+	"time"
+)
+
+const (
+	deploymentKind     = "Deployment"
+	hpaRecentEventsMax = 5
+)
+
+func deploymentAutoscalingRender(gui *guilib.Gui, view *guilib.View) error {
+	view.ReRender()
+	view.Clear()
+
+	deploymentView, err := gui.GetView(deploymentViewName)
+	if err != nil {
+		return err
+	}
+
+	namespace, resourceName, err := getResourceNamespaceAndName(gui, deploymentView)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			showPleaseSelected(view, deploymentResource)
+			return nil
+		}
+		return err
+	}
+
+	hpa, err := kubecli.Cli.GetHPA(namespace, deploymentKind, resourceName)
+	if err != nil {
+		_, err := fmt.Fprint(view, err)
+		return err
+	}
+	if hpa == nil {
+		_, err := fmt.Fprintf(view, "No HorizontalPodAutoscaler found for deployment '%s'.", resourceName)
+		return err
+	}
+
+	lastScale := "<none>"
+	if !hpa.LastScaleTime.IsZero() {
+		lastScale = duration.HumanDuration(time.Since(hpa.LastScaleTime)) + " ago"
+	}
+	fmt.Fprintf(
+		view,
+		"HPA: %s   Min: %d   Max: %d   Current: %s   Desired: %s   Last scale: %s\n\n",
+		color.Green.Sprint(hpa.Name),
+		hpa.MinReplicas,
+		hpa.MaxReplicas,
+		color.Green.Sprint(hpa.CurrentReplicas),
+		color.Green.Sprint(hpa.DesiredReplicas),
+		lastScale,
+	)
+
+	writer := newTabWriter(view)
+	fmt.Fprintln(writer, "METRIC\tCURRENT\tTARGET")
+	for _, metric := range hpa.Metrics {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", metric.Name, metric.Current, metric.Target)
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(view)
+
+	events, err := kubecli.Cli.ListHPAEvents(context.Background(), namespace, hpa.Name)
+	if err != nil {
+		fmt.Fprintln(view, err)
+	} else if len(events) == 0 {
+		fmt.Fprintln(view, "No scaling events.")
+	} else {
+		if len(events) > hpaRecentEventsMax {
+			events = events[:hpaRecentEventsMax]
+		}
+		writer := newTabWriter(view)
+		fmt.Fprintln(writer, "LAST SEEN\tTYPE\tREASON\tMESSAGE")
+		for _, event := range events {
+			fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%s\n",
+				duration.HumanDuration(time.Since(kubecli.EventTime(&event))),
+				event.Type,
+				event.Reason,
+				event.Message,
+			)
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(view)
+
+	replicasPlot := getPlot(
+		gui,
+		view,
+		replicasPlotStateKey,
+		"Replicas: %0.0f (%v)",
+		namespace,
+		resourceName,
+		nil,
+		v1.ResourcePods,
+		color.Cyan.Sprintf,
+	)
+	replicasPlot.DataGetter = func() []float64 {
+		return []float64{float64(hpa.CurrentReplicas)}
+	}
+	replicasPlot.Max = func(plot *guilib.Plot) float64 {
+		return math.Max(float64(hpa.MaxReplicas), utils.MaxFloat64(plot.Data()))
+	}
+	replicasPlot.Min = func(*guilib.Plot) float64 {
+		return 0
+	}
+	replicasPlot.Render(view)
+	return nil
+}
@@ -0,0 +1,372 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/TNK-Studio/lazykube/pkg/config"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"github.com/TNK-Studio/lazykube/pkg/log"
+	"github.com/gookit/color"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	logRangeTimeFormat = "2006-01-02 15:04:05"
+	logsMoreInterval   = 2 * time.Second
+	maxLogsTail        = 100000
+	maxLogsSince       = 7 * 24 * time.Hour
+)
+
+var (
+	logLevelRegexp = regexp.MustCompile(`(?i)\b(fatal|panic|error|err|warning|warn|info|debug)\b`)
+
+	logLevelKeys   = []string{"level", "lvl", "severity", "loglevel"}
+	logMessageKeys = []string{"msg", "message"}
+	logTimeKeys    = []string{"time", "ts", "timestamp", "@timestamp"}
+)
+
+// logRange range of logs to fetch at first, logs after that will be followed.
+type logRange struct {
+	Tail      int64
+	Since     time.Duration
+	SinceTime time.Time
+	moreTime  time.Time
+}
+
+// Start returns the time which logs are fetched since, zero means the last "Tail" lines.
+func (r *logRange) Start() time.Time {
+	if !r.SinceTime.IsZero() {
+		return r.SinceTime
+	}
+	if r.Since > 0 {
+		return time.Now().Add(-r.Since)
+	}
+	return time.Time{}
+}
+
+// More extend the range to load more history, returns false if it is called too frequently or the range reaches the limit.
+func (r *logRange) More() bool {
+	if time.Since(r.moreTime) < logsMoreInterval {
+		return false
+	}
+
+	switch {
+	case !r.SinceTime.IsZero():
+		earliest := time.Now().Add(-maxLogsSince)
+		if !r.SinceTime.After(earliest) {
+			return false
+		}
+		r.SinceTime = r.SinceTime.Add(-time.Since(r.SinceTime))
+		if r.SinceTime.Before(earliest) {
+			r.SinceTime = earliest
+		}
+	case r.Since > 0:
+		if r.Since >= maxLogsSince {
+			return false
+		}
+		r.Since *= 2
+		if r.Since > maxLogsSince {
+			r.Since = maxLogsSince
+		}
+	default:
+		if r.Tail >= maxLogsTail {
+			return false
+		}
+		r.Tail *= 2
+		if r.Tail > maxLogsTail {
+			r.Tail = maxLogsTail
+		}
+	}
+	r.moreTime = time.Now()
+	return true
+}
+
+func (r *logRange) String() string {
+	switch {
+	case !r.SinceTime.IsZero():
+		return "since " + r.SinceTime.Local().Format(logRangeTimeFormat)
+	case r.Since > 0:
+		return "since " + shortDuration(r.Since)
+	default:
+		return fmt.Sprintf("last %d lines", r.Tail)
+	}
+}
+
+// getLogRange returns log range of view, it will be created from config if not existed.
+func getLogRange(view *guilib.View) *logRange {
+	if val, _ := view.GetState(logRangeStateKey); val != nil {
+		if r, ok := val.(*logRange); ok {
+			return r
+		}
+	}
+
+	logsConfig := config.Conf.UserConfig.GetLogsConfig()
+	r := &logRange{Tail: logsConfig.Tail}
+	if r.Tail <= 0 {
+		r.Tail = config.DefaultLogsTail
+	}
+	if logsConfig.Since != "" {
+		since, err := time.ParseDuration(logsConfig.Since)
+		if err != nil {
+			log.Logger.Warningf("getLogRange - time.ParseDuration('%s') error %s", logsConfig.Since, err)
+		} else {
+			r.Since = since
+		}
+	}
+	_ = view.SetState(logRangeStateKey, r, false)
+	return r
+}
+
+// keepLogsScroll remember the top line by its distance to the bottom, logs will be scrolled back to it after reloading.
+func keepLogsScroll(view *guilib.View) {
+	_, oy := view.Origin()
+	_ = view.SetState(logScrollStateKey, len(view.ViewBufferLines())-oy, true)
+}
+
+// restoreLogsScroll scroll to the line remembered by keepLogsScroll once the reloaded logs are drawn.
+func restoreLogsScroll(view *guilib.View) {
+	val, _ := view.GetState(logScrollStateKey)
+	if val == nil {
+		return
+	}
+
+	lines := len(view.ViewBufferLines())
+	if lines == 0 {
+		return
+	}
+	_ = view.SetState(logScrollStateKey, nil, true)
+
+	oy := lines - val.(int)
+	if oy < 0 {
+		oy = 0
+	}
+	_ = view.SetOrigin(0, oy)
+}
+
+// shortDuration format duration like "5m" or "1h" instead of "5m0s".
+func shortDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+	return s
+}
+
+// logCursor the last printed lines of a container, logs are fetched since it again and the printed lines are skipped.
+type logCursor struct {
+	Time time.Time
+	// Messages printed lines at Time.
+	Messages map[string]bool
+	// Err the last printed error of fetching logs.
+	Err string
+}
+
+// Add returns false if the line has been printed.
+func (cursor *logCursor) Add(line *kubecli.LogLine) bool {
+	switch {
+	case line.Time.Before(cursor.Time):
+		return false
+	case line.Time.After(cursor.Time):
+		cursor.Time = line.Time
+		cursor.Messages = make(map[string]bool)
+	case cursor.Messages[line.Message]:
+		return false
+	}
+	cursor.Messages[line.Message] = true
+	return true
+}
+
+// logCursors cursors of containers by "pod/container".
+type logCursors map[string]*logCursor
+
+func containerLogKey(pod, container string) string {
+	return pod + "/" + container
+}
+
+// Get returns cursor of key, it will be created if not existed.
+func (cursors logCursors) Get(key string) *logCursor {
+	cursor, ok := cursors[key]
+	if !ok {
+		cursor = &logCursor{Messages: make(map[string]bool)}
+		cursors[key] = cursor
+	}
+	return cursor
+}
+
+func getLogCursors(view *guilib.View) logCursors {
+	if val, _ := view.GetState(logCursorsStateKey); val != nil {
+		if cursors, ok := val.(logCursors); ok {
+			return cursors
+		}
+	}
+	return make(logCursors)
+}
+
+// logFilter include/exclude regexps and display mode of logs in Detail.
+type logFilter struct {
+	Include  *regexp.Regexp
+	Exclude  *regexp.Regexp
+	Disabled bool
+	Raw      bool
+}
+
+// Match check if log line should be kept.
+func (f *logFilter) Match(line string) bool {
+	if f.Disabled {
+		return true
+	}
+	if f.Include != nil && !f.Include.MatchString(line) {
+		return false
+	}
+	if f.Exclude != nil && f.Exclude.MatchString(line) {
+		return false
+	}
+	return true
+}
+
+// Format format JSON log line and highlight log level.
+func (f *logFilter) Format(line string) string {
+	if !f.Raw {
+		line = formatJSONLog(line)
+	}
+	return highlightLogLevel(line)
+}
+
+func (f *logFilter) String() string {
+	conditions := make([]string, 0)
+	if f.Include != nil {
+		conditions = append(conditions, "include: "+f.Include.String())
+	}
+	if f.Exclude != nil {
+		conditions = append(conditions, "exclude: "+f.Exclude.String())
+	}
+	if f.Disabled && len(conditions) > 0 {
+		conditions = append(conditions, "disabled")
+	}
+	if f.Raw {
+		conditions = append(conditions, "raw")
+	}
+	return strings.Join(conditions, ", ")
+}
+
+// getLogFilter returns log filter of view, it will be created if not existed.
+func getLogFilter(view *guilib.View) *logFilter {
+	if val, _ := view.GetState(logFilterStateKey); val != nil {
+		if filter, ok := val.(*logFilter); ok {
+			return filter
+		}
+	}
+
+	filter := &logFilter{}
+	_ = view.SetState(logFilterStateKey, filter, false)
+	return filter
+}
+
+// detailTitle shows log filter in the title of Detail.
+func detailTitle(gui *guilib.Gui, view *guilib.View) string {
+	if !isLogNavigation(gui, view) {
+		return view.Title
+	}
+	title := getLogRange(view).String()
+	if filter := getLogFilter(view).String(); filter != "" {
+		title = title + ", " + filter
+	}
+	return fmt.Sprintf("Logs (%s)", title)
+}
+
+// writeLogLines write lines which matched log filter to view.
+func writeLogLines(view *guilib.View, lines []string) {
+	filter := getLogFilter(view)
+	for _, line := range lines {
+		if line == "" || !filter.Match(line) {
+			continue
+		}
+		prefix, message := splitLogPrefix(line)
+		fmt.Fprintln(view, prefix+filter.Format(message))
+	}
+}
+
+// splitLogPrefix split the "[pod/name/container] " prefix added by kubectl from log line, so that it will not be highlighted.
+func splitLogPrefix(line string) (string, string) {
+	if !strings.HasPrefix(line, "[") {
+		return "", line
+	}
+	index := strings.Index(line, "] ")
+	if index < 0 {
+		return "", line
+	}
+	return line[:index+2], line[index+2:]
+}
+
+// formatJSONLog show JSON log line as "level msg key=value", the text before JSON will be kept.
+func formatJSONLog(line string) string {
+	index := strings.Index(line, "{")
+	if index < 0 || !strings.HasSuffix(strings.TrimSpace(line), "}") {
+		return line
+	}
+
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(line[index:]), &fields); err != nil {
+		return line
+	}
+
+	level := popLogField(fields, logLevelKeys)
+	message := popLogField(fields, logMessageKeys)
+	popLogField(fields, logTimeKeys)
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	formatted := make([]string, 0, len(keys)+2)
+	if level != "" {
+		formatted = append(formatted, strings.ToUpper(level))
+	}
+	if message != "" {
+		formatted = append(formatted, message)
+	}
+	for _, key := range keys {
+		value := fields[key]
+		if _, ok := value.(string); !ok {
+			if bytes, err := json.Marshal(value); err == nil {
+				value = string(bytes)
+			}
+		}
+		formatted = append(formatted, fmt.Sprintf("%s=%v", key, value))
+	}
+	return line[:index] + strings.Join(formatted, " ")
+}
+
+func popLogField(fields map[string]interface{}, keys []string) string {
+	for _, key := range keys {
+		if value, ok := fields[key]; ok {
+			delete(fields, key)
+			return fmt.Sprint(value)
+		}
+	}
+	return ""
+}
+
+func highlightLogLevel(line string) string {
+	return logLevelRegexp.ReplaceAllStringFunc(line, func(level string) string {
+		switch strings.ToLower(level) {
+		case "fatal", "panic", "error", "err":
+			return color.Red.Sprint(level)
+		case "warning", "warn":
+			return color.Yellow.Sprint(level)
+		case "info":
+			return color.Green.Sprint(level)
+		default:
+			return color.Blue.Sprint(level)
+		}
+	})
+}
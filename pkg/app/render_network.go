@@ -0,0 +1,288 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"github.com/gookit/color"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"strings"
+)
+
+const (
+	noEndpointSlicesFound = "No endpoint slices found."
+	noIngressRulesFound   = "No ingress rules found."
+)
+
+func serviceEndpointsRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	serviceView, err := gui.GetView(serviceViewName)
+	if err != nil {
+		return nil
+	}
+
+	namespace, name, err := getResourceNamespaceAndName(gui, serviceView)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			showPleaseSelected(view, serviceResource)
+			return nil
+		}
+		return err
+	}
+
+	slices, err := kubecli.Cli.ListServiceEndpointSlices(namespace, name)
+	if err != nil {
+		_, err := fmt.Fprint(view, err)
+		return err
+	}
+	if len(slices) == 0 {
+		_, err := fmt.Fprint(view, noEndpointSlicesFound)
+		return err
+	}
+
+	for _, slice := range slices {
+		fmt.Fprintf(
+			view,
+			"%s   AddressType: %s   Ports: %s\n",
+			color.Green.Sprint(slice.Name),
+			slice.AddressType,
+			strings.Join(slice.Ports, ","),
+		)
+
+		writer := newTabWriter(view)
+		fmt.Fprintln(writer, "ADDRESSES\tREADY\tPOD\tNODE")
+		for _, endpoint := range slice.Endpoints {
+			ready := color.Green.Sprint("true")
+			if !endpoint.Ready {
+				ready = color.Red.Sprint("false")
+			}
+			fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%s\n",
+				strings.Join(endpoint.Addresses, ","),
+				ready,
+				endpoint.Pod,
+				endpoint.Node,
+			)
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintln(view)
+	}
+	return nil
+}
+
+func ingressRulesRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	ingressView, err := gui.GetView(ingressViewName)
+	if err != nil {
+		return nil
+	}
+
+	namespace, name, err := getResourceNamespaceAndName(gui, ingressView)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			showPleaseSelected(view, ingressResource)
+			return nil
+		}
+		return err
+	}
+
+	backends, err := kubecli.Cli.GetIngressBackends(context.Background(), namespace, name)
+	if err != nil {
+		_, err := fmt.Fprint(view, err)
+		return err
+	}
+	if len(backends) == 0 {
+		_, err := fmt.Fprint(view, noIngressRulesFound)
+		return err
+	}
+
+	writer := newTabWriter(view)
+	fmt.Fprintln(writer, "HOST\tPATH\tSERVICE\tPORT\tREADY ENDPOINTS")
+	for _, backend := range backends {
+		readyEndpoints := color.Green.Sprint(backend.ReadyEndpoints)
+		if backend.ReadyEndpoints == 0 && backend.Port != "" {
+			readyEndpoints = color.Red.Sprintf("%d (no ready endpoints)", backend.ReadyEndpoints)
+		}
+		fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%s\t%s\n",
+			backend.Host,
+			backend.Path,
+			backend.Service,
+			backend.Port,
+			readyEndpoints,
+		)
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	urls, err := kubecli.Cli.GetIngressURLs(context.Background(), namespace, name)
+	if err != nil || len(urls) == 0 {
+		return nil
+	}
+	fmt.Fprintln(view)
+	fmt.Fprintln(view, color.Green.Sprint("URLs"))
+	for _, url := range urls {
+		fmt.Fprintln(view, url)
+	}
+	return nil
+}
+
+func clusterURLsRender(_ *guilib.Gui, view *guilib.View) error {
+	info, err := kubecli.Cli.ClusterInfo()
+	if err != nil {
+		info = err.Error()
+	}
+	_, err = fmt.Fprint(view, info)
+	return err
+}
+
+func podNetworkPoliciesRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	podView, err := gui.GetView(podViewName)
+	if err != nil {
+		return nil
+	}
+
+	namespace, name, err := getResourceNamespaceAndName(gui, podView)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			showPleaseSelected(view, podResource)
+			return nil
+		}
+		return err
+	}
+
+	pod, policies, err := kubecli.Cli.GetPodNetworkPolicies(context.Background(), namespace, name)
+	if err != nil {
+		_, err := fmt.Fprint(view, err)
+		return err
+	}
+
+	var ingressIsolated, egressIsolated bool
+	for index := range policies {
+		ingress, egress := kubecli.NetworkPolicyTypes(&policies[index])
+		ingressIsolated = ingressIsolated || ingress
+		egressIsolated = egressIsolated || egress
+	}
+
+	fmt.Fprintf(view, "Pod: %s   Labels: %s\n", color.Green.Sprint(pod.Name), labels.Set(pod.Labels).String())
+	fmt.Fprintf(view, "Ingress: %s\n", isolationDescription(ingressIsolated))
+	fmt.Fprintf(view, "Egress: %s\n", isolationDescription(egressIsolated))
+	if len(policies) == 0 {
+		_, err := fmt.Fprintf(view, "\nNo network policies select pod '%s'.", name)
+		return err
+	}
+
+	for index := range policies {
+		policy := &policies[index]
+		ingress, egress := kubecli.NetworkPolicyTypes(policy)
+		fmt.Fprintf(view, "\n%s\n", color.Green.Sprintf("NetworkPolicy: %s", policy.Name))
+		if ingress {
+			fmt.Fprintln(view, "  Ingress:")
+			if len(policy.Spec.Ingress) == 0 {
+				fmt.Fprintln(view, color.Red.Sprint("    Deny all ingress traffic."))
+			}
+			for _, rule := range policy.Spec.Ingress {
+				fmt.Fprintf(
+					view,
+					"    - Allow from %s on %s\n",
+					networkPolicyPeersDescription(rule.From, policy.Namespace),
+					networkPolicyPortsDescription(rule.Ports),
+				)
+			}
+		}
+		if egress {
+			fmt.Fprintln(view, "  Egress:")
+			if len(policy.Spec.Egress) == 0 {
+				fmt.Fprintln(view, color.Red.Sprint("    Deny all egress traffic."))
+			}
+			for _, rule := range policy.Spec.Egress {
+				fmt.Fprintf(
+					view,
+					"    - Allow to %s on %s\n",
+					networkPolicyPeersDescription(rule.To, policy.Namespace),
+					networkPolicyPortsDescription(rule.Ports),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+func isolationDescription(isolated bool) string {
+	if isolated {
+		return color.Yellow.Sprint("isolated, only traffic allowed by the network policies below is permitted")
+	}
+	return color.Green.Sprint("not isolated, all traffic is allowed")
+}
+
+func networkPolicyPeersDescription(peers []networkingv1.NetworkPolicyPeer, namespace string) string {
+	if len(peers) == 0 {
+		return "anywhere"
+	}
+
+	descriptions := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		if peer.IPBlock != nil {
+			description := fmt.Sprintf("CIDR %s", peer.IPBlock.CIDR)
+			if len(peer.IPBlock.Except) > 0 {
+				description += fmt.Sprintf(" except %s", strings.Join(peer.IPBlock.Except, ","))
+			}
+			descriptions = append(descriptions, description)
+			continue
+		}
+
+		pods := "all pods"
+		if peer.PodSelector != nil && labelSelectorDescription(peer.PodSelector) != "" {
+			pods = fmt.Sprintf("pods matching '%s'", labelSelectorDescription(peer.PodSelector))
+		}
+
+		namespaces := fmt.Sprintf("in namespace '%s'", namespace)
+		if peer.NamespaceSelector != nil {
+			namespaces = "in all namespaces"
+			if labelSelectorDescription(peer.NamespaceSelector) != "" {
+				namespaces = fmt.Sprintf("in namespaces matching '%s'", labelSelectorDescription(peer.NamespaceSelector))
+			}
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%s %s", pods, namespaces))
+	}
+	return strings.Join(descriptions, ", or ")
+}
+
+func networkPolicyPortsDescription(ports []networkingv1.NetworkPolicyPort) string {
+	if len(ports) == 0 {
+		return "all ports"
+	}
+
+	descriptions := make([]string, 0, len(ports))
+	for _, port := range ports {
+		protocol := v1.ProtocolTCP
+		if port.Protocol != nil {
+			protocol = *port.Protocol
+		}
+		if port.Port == nil {
+			descriptions = append(descriptions, fmt.Sprintf("%s/all", protocol))
+			continue
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%s/%s", protocol, port.Port.String()))
+	}
+	return "ports " + strings.Join(descriptions, ",")
+}
+
+func labelSelectorDescription(selector *metav1.LabelSelector) string {
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return err.Error()
+	}
+	return labelSelector.String()
+}
@@ -5,14 +5,16 @@ import (
 	"fmt"
 	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
 	"github.com/TNK-Studio/lazykube/pkg/kubecli"
-	"github.com/TNK-Studio/lazykube/pkg/log"
 	"github.com/TNK-Studio/lazykube/pkg/utils"
 	"github.com/gookit/color"
 	v1 "k8s.io/api/core/v1"
 	"math"
+	"sort"
 	"time"
 )
 
+const oomRiskRatio = 0.9
+
 func podMetricsPlotRender(gui *guilib.Gui, view *guilib.View) error {
 	view.ReRender()
 	//if !canRenderPlot(gui, view) {
@@ -38,61 +40,91 @@ func podMetricsPlotRender(gui *guilib.Gui, view *guilib.View) error {
 		return err
 	}
 
-	fmt.Fprintln(view)
-	cpuPlot := getPlot(
+	getTopMetrics(
 		gui,
 		view,
-		cpuPlotStateKey,
-		"CPU: %0.0fm (%v)",
-		namespace,
-		resourceName,
-		func() []float64 {
-			data := make([]float64, 0)
-			metrics, err := kubecli.Cli.GetPodMetrics(namespace, resourceName, false, nil)
-			if err != nil {
-				log.Logger.Warningf("podMetricsDataGetter - kubecli.Cli.GetPodMetrics('%s', '%s', false, nil) error %s", namespace, resourceName, err)
-			}
-			if metrics == nil {
-				return data
-			}
-			for _, m := range metrics {
-				data = append(data, float64(m[v1.ResourceCPU]))
-			}
-			return data
+		fmt.Sprintf("%s - %s", namespace, resourceName),
+		func() (map[string]kubecli.ResourceUsage, error) {
+			return kubecli.Cli.GetPodContainersMetrics(namespace, resourceName)
 		},
-		v1.ResourceCPU,
-		color.Blue.Sprintf,
-	)
-	cpuPlot.Render(view)
-	fmt.Fprintln(view)
-	memoryPlot := getPlot(
-		gui,
-		view,
-		memoryPlotStateKey,
-		"Memory: %0.0fMi (%v)",
-		namespace,
-		resourceName,
-		func() []float64 {
-			data := make([]float64, 0)
-			metrics, err := kubecli.Cli.GetPodMetrics(namespace, resourceName, false, nil)
+		func() ([]metricsLine, error) {
+			containers, err := kubecli.Cli.GetPodContainersRequestsAndLimits(namespace, resourceName)
 			if err != nil {
-				log.Logger.Warningf("podMetricsDataGetter - kubecli.Cli.GetPodMetrics('%s', '%s', false, nil) error %s", namespace, resourceName, err)
-			}
-			if metrics == nil {
-				return data
+				return nil, err
 			}
-			for _, m := range metrics {
-				data = append(data, float64(m[v1.ResourceMemory]))
+			return containersRequestsAndLimitsLines(containers), nil
+		},
+		containersUsageWarnings,
+		func() []*metricsSample {
+			if podMetricsCollector == nil {
+				return nil
 			}
-			return data
+			return podMetricsCollector.History(kubecli.Cli.CurrentContext(), namespace, resourceName)
 		},
-		v1.ResourceMemory,
-		color.Green.Sprintf,
-	)
-	memoryPlot.Render(view)
+	).render(view, "")
 	return nil
 }
 
+func containersRequestsAndLimitsLines(containers map[string]*kubecli.RequestsAndLimits) []metricsLine {
+	names := make([]string, 0, len(containers))
+	for name := range containers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]metricsLine, 0, len(names)*2)
+	for _, name := range names {
+		// Lines of container have the same color as its series, they are told apart by the dashed glyph and label.
+		for _, line := range requestsAndLimitsLines(containers[name]) {
+			line.object = name
+			line.sprint = seriesColor(name)
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// containersUsageWarnings warns about containers using more than requests or close to memory limit.
+func containersUsageWarnings(metrics *topMetrics) []string {
+	warnings := make([]string, 0)
+	for _, line := range metrics.lines {
+		usage, ok := metrics.usages[line.object]
+		if !ok {
+			continue
+		}
+		for _, res := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			threshold := line.usage[res]
+			if threshold == 0 {
+				continue
+			}
+			switch {
+			case line.name == requestsLineName && usage[res] > threshold:
+				warnings = append(warnings, color.Yellow.Sprintf(
+					"Warning: container %s %s usage %s is over request %s.",
+					line.object, res, formatUsage(res, usage[res]), formatUsage(res, threshold),
+				))
+			case line.name == limitsLineName && res == v1.ResourceMemory && float64(usage[res]) >= float64(threshold)*oomRiskRatio:
+				warnings = append(warnings, color.Red.Sprintf(
+					"Warning: container %s memory usage %s is %d%% of limit %s, it may be OOM killed.",
+					line.object, formatUsage(res, usage[res]), usage[res]*100/threshold, formatUsage(res, threshold),
+				))
+			}
+		}
+	}
+	return warnings
+}
+
+func formatUsage(resourceName v1.ResourceName, value int64) string {
+	switch resourceName {
+	case v1.ResourceCPU:
+		return fmt.Sprintf("%dm", value)
+	case v1.ResourceMemory:
+		return fmt.Sprintf("%dMi", value)
+	default:
+		return fmt.Sprintf("%d", value)
+	}
+}
+
 func getPlot(gui *guilib.Gui, view *guilib.View, plotStateKey, captionFormat, namespace, name string, dataGetter func() []float64, resourceName v1.ResourceName, colorSprintf func(format string, args ...interface{}) string) *guilib.Plot {
 	var plot *guilib.Plot
 	plotName := fmt.Sprintf("%s - %s", namespace, name)
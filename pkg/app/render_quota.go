@@ -0,0 +1,144 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"github.com/gookit/color"
+	v1 "k8s.io/api/core/v1"
+	"sort"
+)
+
+const (
+	quotaGaugeWidth       = 30
+	noResourceQuotasFound = "No resource quotas found."
+	noLimitRangesFound    = "No limit ranges found."
+)
+
+func namespaceQuotasRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	namespaceView, err := gui.GetView(namespaceViewName)
+	if err != nil {
+		return nil
+	}
+	namespace := formatSelectedNamespace(namespaceView.SelectedLine)
+	if notResourceSelected(namespace) {
+		showPleaseSelected(view, namespaceViewName)
+		return nil
+	}
+
+	if err := resourceQuotasRender(view, namespace); err != nil {
+		return err
+	}
+	fmt.Fprintln(view)
+	return limitRangesRender(view, namespace)
+}
+
+func resourceQuotasRender(view *guilib.View, namespace string) error {
+	quotas, err := kubecli.Cli.ListResourceQuotas(context.Background(), namespace)
+	if err != nil {
+		_, err := fmt.Fprintln(view, err)
+		return err
+	}
+	if len(quotas) == 0 {
+		_, err := fmt.Fprintln(view, noResourceQuotasFound)
+		return err
+	}
+
+	for _, quota := range quotas {
+		fmt.Fprintln(view, color.Green.Sprintf("ResourceQuota: %s", quota.Name))
+
+		resources := make([]string, 0, len(quota.Status.Hard))
+		for resource := range quota.Status.Hard {
+			resources = append(resources, string(resource))
+		}
+		sort.Strings(resources)
+
+		writer := newTabWriter(view)
+		fmt.Fprintln(writer, "RESOURCE\tUSED\tHARD\tUSAGE")
+		for _, resource := range resources {
+			hard := quota.Status.Hard[v1.ResourceName(resource)]
+			used := quota.Status.Used[v1.ResourceName(resource)]
+			ratio := 0.0
+			if hard.MilliValue() > 0 {
+				ratio = float64(used.MilliValue()) / float64(hard.MilliValue())
+			} else if used.MilliValue() > 0 {
+				ratio = 1
+			}
+			fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%s\n",
+				resource,
+				used.String(),
+				hard.String(),
+				colorfulGauge(ratio, quotaGaugeWidth),
+			)
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintln(view)
+	}
+	return nil
+}
+
+func limitRangesRender(view *guilib.View, namespace string) error {
+	limitRanges, err := kubecli.Cli.ListLimitRanges(context.Background(), namespace)
+	if err != nil {
+		_, err := fmt.Fprintln(view, err)
+		return err
+	}
+	if len(limitRanges) == 0 {
+		_, err := fmt.Fprintln(view, noLimitRangesFound)
+		return err
+	}
+
+	for _, limitRange := range limitRanges {
+		fmt.Fprintln(view, color.Green.Sprintf("LimitRange: %s", limitRange.Name))
+
+		writer := newTabWriter(view)
+		fmt.Fprintln(writer, "TYPE\tRESOURCE\tMIN\tMAX\tDEFAULT REQUEST\tDEFAULT LIMIT\tMAX LIMIT/REQUEST RATIO")
+		for _, item := range limitRange.Spec.Limits {
+			resources := make(map[v1.ResourceName]bool)
+			for _, resourceList := range []v1.ResourceList{item.Min, item.Max, item.DefaultRequest, item.Default, item.MaxLimitRequestRatio} {
+				for resource := range resourceList {
+					resources[resource] = true
+				}
+			}
+
+			names := make([]string, 0, len(resources))
+			for resource := range resources {
+				names = append(names, string(resource))
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				resource := v1.ResourceName(name)
+				fmt.Fprintf(
+					writer,
+					"%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					item.Type,
+					name,
+					resourceListValue(item.Min, resource),
+					resourceListValue(item.Max, resource),
+					resourceListValue(item.DefaultRequest, resource),
+					resourceListValue(item.Default, resource),
+					resourceListValue(item.MaxLimitRequestRatio, resource),
+				)
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintln(view)
+	}
+	return nil
+}
+
+func resourceListValue(resourceList v1.ResourceList, resource v1.ResourceName) string {
+	if value, ok := resourceList[resource]; ok {
+		return value.String()
+	}
+	return "-"
+}
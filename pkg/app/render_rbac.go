@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"github.com/gookit/color"
+	"strings"
+)
+
+func namespacePermissionsRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	namespaceView, err := gui.GetView(namespaceViewName)
+	if err != nil {
+		return nil
+	}
+	namespace := formatSelectedNamespace(namespaceView.SelectedLine)
+	if notResourceSelected(namespace) {
+		showPleaseSelected(view, namespaceViewName)
+		return nil
+	}
+
+	rules, incomplete, err := kubecli.Cli.ListResourceRules(context.Background(), namespace)
+	if err != nil {
+		_, err := fmt.Fprint(view, err)
+		return err
+	}
+
+	fmt.Fprintf(
+		view,
+		"Context: %s   Namespace: %s\n",
+		color.Green.Sprint(kubecli.Cli.CurrentContext()),
+		color.Green.Sprint(namespace),
+	)
+	if incomplete {
+		fmt.Fprintln(view, color.Yellow.Sprint("Rules may be incomplete, it depends on the authorizer of cluster."))
+	}
+	fmt.Fprintln(view)
+
+	writer := newTabWriter(view)
+	fmt.Fprintln(writer, "RESOURCES\tRESOURCE NAMES\tVERBS")
+	for _, rule := range rules {
+		fmt.Fprintf(
+			writer,
+			"%s\t[%s]\t[%s]\n",
+			rule.Resource,
+			strings.Join(rule.ResourceNames, " "),
+			strings.Join(rule.Verbs, " "),
+		)
+	}
+	return writer.Flush()
+}
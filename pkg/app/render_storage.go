@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"github.com/gookit/color"
+	"strings"
+)
+
+const (
+	noPersistentVolumeClaimsFound = "No persistent volume claims found."
+)
+
+func persistentVolumeClaimsRender(_ *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	namespace := kubecli.Cli.Namespace()
+	claims, err := kubecli.Cli.ListPersistentVolumeClaims(context.Background(), namespace)
+	if err != nil {
+		_, err := fmt.Fprint(view, err)
+		return err
+	}
+
+	if len(claims) == 0 {
+		_, err := fmt.Fprint(view, noPersistentVolumeClaimsFound)
+		return err
+	}
+
+	writer := newTabWriter(view)
+	header := "NAME\tSTATUS\tCAPACITY\tACCESS MODES\tSTORAGECLASS\tVOLUME\tMOUNTED BY"
+	if namespace == "" {
+		header = "NAMESPACE\t" + header
+	}
+	fmt.Fprintln(writer, header)
+	for _, claim := range claims {
+		mountedBy := "<none>"
+		if len(claim.MountedBy) > 0 {
+			mountedBy = strings.Join(claim.MountedBy, ",")
+		}
+		row := fmt.Sprintf(
+			"%s\t%s\t%s\t%s\t%s\t%s\t%s",
+			claim.Name,
+			claim.Status.Phase,
+			claim.Capacity(),
+			claim.AccessModes(),
+			claim.StorageClass(),
+			claim.Spec.VolumeName,
+			mountedBy,
+		)
+		if namespace == "" {
+			row = claim.Namespace + "\t" + row
+		}
+		fmt.Fprintln(writer, row)
+	}
+	return writer.Flush()
+}
+
+func persistentVolumeClaimPodsRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	storageView, err := gui.GetView(storageViewName)
+	if err != nil {
+		return nil
+	}
+
+	namespace, name, err := getResourceNamespaceAndName(gui, storageView)
+	if err != nil {
+		if errors.Is(err, noResourceSelectedErr) {
+			showPleaseSelected(view, persistentVolumeClaimResource)
+			return nil
+		}
+		return err
+	}
+
+	claims, err := kubecli.Cli.ListPersistentVolumeClaims(context.Background(), namespace)
+	if err != nil {
+		_, err := fmt.Fprint(view, err)
+		return err
+	}
+
+	for _, claim := range claims {
+		if claim.Name != name {
+			continue
+		}
+		if len(claim.MountedBy) == 0 {
+			_, err := fmt.Fprintf(view, "No pods mounting persistent volume claim '%s'.", name)
+			return err
+		}
+		cli(namespace).Get(viewStreams(view), append([]string{"pods"}, claim.MountedBy...)...).SetFlag("output", "wide").Run()
+		return nil
+	}
+	_, err = fmt.Fprint(view, resourceNotFound)
+	return err
+}
+
+func clusterStorageRender(_ *guilib.Gui, view *guilib.View) error {
+	fmt.Fprintln(view, color.Green.Sprint("PersistentVolumes"))
+	kubecli.Cli.Get(viewStreams(view), "persistentvolumes").Run()
+	fmt.Fprintln(view)
+	fmt.Fprintln(view, color.Green.Sprint("StorageClasses"))
+	kubecli.Cli.Get(viewStreams(view), "storageclasses").Run()
+	return nil
+}
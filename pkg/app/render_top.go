@@ -0,0 +1,392 @@
+package app
+
+import (
+	"fmt"
+	guilib "github.com/TNK-Studio/lazykube/pkg/gui"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli"
+	"github.com/TNK-Studio/lazykube/pkg/log"
+	"github.com/gookit/color"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	totalSeriesName      = "total"
+	requestsLineName     = "requests"
+	limitsLineName       = "limits"
+	allocatableLineName  = "allocatable"
+	nodesTopMetricsName  = "nodes"
+	topPlotCaptionFormat = "%s (%v)"
+	// Plots below a long table will be scrolled instead of being squeezed.
+	topPlotMinHeight = 8
+
+	// Requests and limits of all pods are expensive to list, they are refreshed less frequently than usages.
+	nodesLinesRefreshInterval = 30 * time.Second
+)
+
+type metricsLine struct {
+	// object is the series which the line belongs to, empty means all series.
+	object string
+	name   string
+	usage  kubecli.ResourceUsage
+	sprint func(format string, a ...interface{}) string
+}
+
+func (line metricsLine) label() string {
+	if line.object == "" {
+		return line.name
+	}
+	return fmt.Sprintf("%s %s", line.object, line.name)
+}
+
+// topMetrics sum and per object usages with requests and limits lines.
+type topMetrics struct {
+	name           string
+	usagesGetter   func() (map[string]kubecli.ResourceUsage, error)
+	linesGetter    func() ([]metricsLine, error)
+	warningsGetter func(metrics *topMetrics) []string
+	// historyGetter returns collected samples, they are prepended to plots before live usages.
+	historyGetter func() []*metricsSample
+	historyLoaded bool
+	usages        map[string]kubecli.ResourceUsage
+	lines         []metricsLine
+	warnings      []string
+	// tableLines lines of "kubectl top" table above plots.
+	tableLines int
+	cpuPlot    *guilib.SeriesPlot
+	memoryPlot *guilib.SeriesPlot
+
+	// sampleInterval interval of appending live usages to plots, it is the interval of history so that x axis has one time scale.
+	sampleInterval time.Duration
+	sampledAt      time.Time
+}
+
+func getTopMetrics(
+	gui *guilib.Gui,
+	view *guilib.View,
+	name string,
+	usagesGetter func() (map[string]kubecli.ResourceUsage, error),
+	linesGetter func() ([]metricsLine, error),
+	warningsGetter func(metrics *topMetrics) []string,
+	historyGetter func() []*metricsSample,
+) *topMetrics {
+	// Metrics of different contexts should not be plotted together.
+	name = fmt.Sprintf("%s - %s", kubecli.Cli.CurrentContext(), name)
+	val, _ := view.GetState(topMetricsStateKey)
+	if val != nil && val.(*topMetrics).name == name {
+		return val.(*topMetrics)
+	}
+
+	metrics := &topMetrics{
+		name:           name,
+		usagesGetter:   usagesGetter,
+		linesGetter:    linesGetter,
+		warningsGetter: warningsGetter,
+		historyGetter:  historyGetter,
+	}
+	metrics.cpuPlot = metrics.newPlot(gui, view, v1.ResourceCPU, "CPU", "%0.0fm")
+	metrics.memoryPlot = metrics.newPlot(gui, view, v1.ResourceMemory, "Memory", "%0.0fMi")
+	_ = view.SetState(topMetricsStateKey, metrics, false)
+	return metrics
+}
+
+func (metrics *topMetrics) newPlot(gui *guilib.Gui, view *guilib.View, resourceName v1.ResourceName, title, valueFormat string) *guilib.SeriesPlot {
+	return guilib.NewSeriesPlot(
+		metrics.name,
+		totalSeriesName,
+		valueFormat,
+		func() map[string]float64 {
+			return metrics.values(resourceName)
+		},
+		func(*guilib.SeriesPlot) []guilib.PlotLine {
+			return metrics.plotLines(resourceName)
+		},
+		func(*guilib.SeriesPlot) int {
+			_, maxHeight := view.Size()
+
+			// Leave space for table, warnings, caption and legends.
+			height := (maxHeight-metrics.tableLines-len(metrics.warnings))/2 - 4
+			if height < topPlotMinHeight {
+				return topPlotMinHeight
+			}
+			return height
+		},
+		seriesPlotWidth(gui, view),
+		seriesPlotMax,
+		seriesPlotMin,
+		seriesPlotCaption(title),
+		seriesColor,
+	)
+}
+
+func (metrics *topMetrics) refresh() {
+	usages, err := metrics.usagesGetter()
+	if err != nil {
+		log.Logger.Warningf("topMetrics.refresh - '%s' get usages error %s", metrics.name, err)
+		usages = make(map[string]kubecli.ResourceUsage)
+	}
+	metrics.usages = usages
+
+	lines, err := metrics.linesGetter()
+	if err != nil {
+		log.Logger.Warningf("topMetrics.refresh - '%s' get lines error %s", metrics.name, err)
+	} else {
+		metrics.lines = lines
+	}
+
+	if metrics.warningsGetter != nil {
+		metrics.warnings = metrics.warningsGetter(metrics)
+	}
+}
+
+func (metrics *topMetrics) values(resourceName v1.ResourceName) map[string]float64 {
+	return seriesValues(metrics.usages, resourceName)
+}
+
+// loadHistory prepend collected samples to plots, it is only done once since live usages are appended after that.
+func (metrics *topMetrics) loadHistory() {
+	metrics.historyLoaded = true
+	if metrics.historyGetter == nil {
+		return
+	}
+
+	history := metrics.historyGetter()
+	if len(history) == 0 {
+		return
+	}
+	metrics.cpuPlot.Load(historyValues(history, v1.ResourceCPU), history[0].Time)
+	metrics.memoryPlot.Load(historyValues(history, v1.ResourceMemory), history[0].Time)
+
+	last := history[len(history)-1]
+	metrics.sampledAt = last.Time
+	if len(history) > 1 {
+		metrics.sampleInterval = last.Time.Sub(history[0].Time) / time.Duration(len(history)-1)
+	}
+}
+
+// sample append live usages to plots if the sample interval is passed.
+func (metrics *topMetrics) sample() {
+	now := time.Now()
+	if now.Sub(metrics.sampledAt) < metrics.sampleInterval {
+		return
+	}
+	metrics.sampledAt = now
+	metrics.cpuPlot.Append(metrics.values(v1.ResourceCPU))
+	metrics.memoryPlot.Append(metrics.values(v1.ResourceMemory))
+}
+
+func historyValues(history []*metricsSample, resourceName v1.ResourceName) []map[string]float64 {
+	values := make([]map[string]float64, 0, len(history))
+	for _, sample := range history {
+		values = append(values, seriesValues(sample.Usages, resourceName))
+	}
+	return values
+}
+
+func seriesValues(usages map[string]kubecli.ResourceUsage, resourceName v1.ResourceName) map[string]float64 {
+	values := make(map[string]float64)
+	for name, usage := range usages {
+		values[name] = float64(usage[resourceName])
+	}
+
+	// The sum of a single series is itself.
+	if len(usages) < 2 {
+		return values
+	}
+
+	var total float64
+	for _, usage := range usages {
+		total += float64(usage[resourceName])
+	}
+	values[totalSeriesName] = total
+	return values
+}
+
+func (metrics *topMetrics) plotLines(resourceName v1.ResourceName) []guilib.PlotLine {
+	lines := make([]guilib.PlotLine, 0)
+	for _, line := range metrics.lines {
+		value, ok := line.usage[resourceName]
+		if !ok || value == 0 {
+			continue
+		}
+		lines = append(lines, guilib.PlotLine{Name: line.label(), Value: float64(value), Color: line.sprint})
+	}
+	return lines
+}
+
+// render render plots below table, table is the output of "kubectl top" or empty.
+func (metrics *topMetrics) render(view *guilib.View, table string) {
+	metrics.refresh()
+	if !metrics.historyLoaded {
+		metrics.loadHistory()
+	}
+	metrics.sample()
+	metrics.tableLines = strings.Count(table, "\n")
+	fmt.Fprint(view, table)
+	for _, warning := range metrics.warnings {
+		fmt.Fprintln(view, warning)
+	}
+	fmt.Fprintln(view)
+	metrics.cpuPlot.Write(view)
+	fmt.Fprintln(view)
+	metrics.memoryPlot.Write(view)
+}
+
+func requestsAndLimitsLines(requestsAndLimits *kubecli.RequestsAndLimits) []metricsLine {
+	return []metricsLine{
+		{name: requestsLineName, usage: requestsAndLimits.Requests, sprint: color.Yellow.Sprintf},
+		{name: limitsLineName, usage: requestsAndLimits.Limits, sprint: color.Red.Sprintf},
+	}
+}
+
+func podsRequestsAndLimitsLines(namespace string, allNamespaces bool, selector labels.Selector) func() ([]metricsLine, error) {
+	return func() ([]metricsLine, error) {
+		requestsAndLimits, err := kubecli.Cli.GetPodsRequestsAndLimits(namespace, allNamespaces, selector)
+		if err != nil {
+			return nil, err
+		}
+		return requestsAndLimitsLines(requestsAndLimits), nil
+	}
+}
+
+// cacheMetricsLines returns lines getter which only calls getter again after interval.
+func cacheMetricsLines(getter func() ([]metricsLine, error), interval time.Duration) func() ([]metricsLine, error) {
+	var (
+		lines     []metricsLine
+		updatedAt time.Time
+	)
+	return func() ([]metricsLine, error) {
+		if lines != nil && time.Since(updatedAt) < interval {
+			return lines, nil
+		}
+
+		newLines, err := getter()
+		if err != nil {
+			return nil, err
+		}
+		lines, updatedAt = newLines, time.Now()
+		return lines, nil
+	}
+}
+
+func topNodesRender(gui *guilib.Gui, view *guilib.View) error {
+	stream := newStream()
+	getTopMetrics(
+		gui,
+		view,
+		nodesTopMetricsName,
+		func() (map[string]kubecli.ResourceUsage, error) {
+			return kubecli.Cli.GetNodesMetrics()
+		},
+		cacheMetricsLines(func() ([]metricsLine, error) {
+			requestsAndLimits, err := kubecli.Cli.GetPodsRequestsAndLimits("", true, nil)
+			if err != nil {
+				return nil, err
+			}
+			allocatable, err := kubecli.Cli.GetNodesAllocatable()
+			if err != nil {
+				return nil, err
+			}
+			return append(
+				requestsAndLimitsLines(requestsAndLimits),
+				metricsLine{name: allocatableLineName, usage: allocatable, sprint: color.Blue.Sprintf},
+			), nil
+		}, nodesLinesRefreshInterval),
+		nil,
+		nil,
+	).render(view, topTable(kubecli.Cli.TopNode(stream, nil, ""), stream))
+	return nil
+}
+
+func namespaceTopPodsRender(gui *guilib.Gui, view *guilib.View) error {
+	namespace := kubecli.Cli.Namespace()
+	allNamespaces := kubecli.Cli.AllNamespaces()
+	name := namespace
+	stream := newStream()
+	cmd := kubecli.Cli.TopPod(stream, nil)
+	if allNamespaces {
+		name = allNamespacesMode
+		cmd.SetFlag("all-namespaces", "true")
+	}
+
+	getTopMetrics(
+		gui,
+		view,
+		name,
+		func() (map[string]kubecli.ResourceUsage, error) {
+			return kubecli.Cli.GetPodsMetrics(namespace, allNamespaces, nil)
+		},
+		podsRequestsAndLimitsLines(namespace, allNamespaces, nil),
+		nil,
+		nil,
+	).render(view, topTable(cmd, stream))
+	return nil
+}
+
+func topPodsRender(gui *guilib.Gui, view *guilib.View) error {
+	view.Clear()
+	if err := podsSelectorRenderHelper(func(namespace string, labelsArr []string) error {
+		selector, err := labels.Parse(strings.Join(labelsArr, ","))
+		if err != nil {
+			return err
+		}
+
+		stream := newStream()
+		cmd := kubecli.Cli.WithNamespace(namespace).TopPod(stream, nil)
+		cmd.SetFlag("selector", strings.Join(labelsArr, ","))
+
+		getTopMetrics(
+			gui,
+			view,
+			fmt.Sprintf("%s - %s", namespace, selector.String()),
+			func() (map[string]kubecli.ResourceUsage, error) {
+				return kubecli.Cli.GetPodsMetrics(namespace, false, selector)
+			},
+			podsRequestsAndLimitsLines(namespace, false, selector),
+			nil,
+			nil,
+		).render(view, topTable(cmd, stream))
+		return nil
+	})(gui, view); err != nil {
+		return err
+	}
+	return nil
+}
+
+// topTable run "kubectl top" command and returns its table, plots are drawn below it.
+func topTable(cmd *kubecli.Cmd, stream genericclioptions.IOStreams) string {
+	cmd.Run()
+	return streamToString(stream)
+}
+
+func seriesPlotWidth(gui *guilib.Gui, view *guilib.View) func(*guilib.SeriesPlot) int {
+	return func(*guilib.SeriesPlot) int {
+		maxWidth, _ := view.Size()
+		return maxWidth - 2
+	}
+}
+
+func seriesPlotMax(plot *guilib.SeriesPlot) float64 {
+	return plot.MaxValue() * 1.2
+}
+
+func seriesPlotMin(plot *guilib.SeriesPlot) float64 {
+	return math.Min(0, plot.MinValue())
+}
+
+func seriesPlotCaption(title string) func(*guilib.SeriesPlot) string {
+	return func(plot *guilib.SeriesPlot) string {
+		return fmt.Sprintf(topPlotCaptionFormat, title, time.Since(plot.Since()).Round(time.Second))
+	}
+}
+
+func seriesColor(series string) func(format string, a ...interface{}) string {
+	if series == totalSeriesName {
+		return color.White.Sprintf
+	}
+	return podLogColor(series).Sprintf
+}
@@ -2,8 +2,8 @@ package app
 
 const (
 	viewLastRenderTimeStateKey    = "viewLastRenderTime"  // value type: time.Time
-	cpuPlotStateKey               = "cpuPlot"             // value type: *gui.Plot
-	memoryPlotStateKey            = "memoryPlot"          // value type: *gui.Plot
+	topMetricsStateKey            = "topMetrics"          // value type: *topMetrics
+	replicasPlotStateKey          = "replicasPlot"        // value type: *gui.Plot
 	moreActionTriggerViewStateKey = "triggerView"         // value type: *gui.View
 	filterInputValueStateKey      = "filterInputValue"    // value type: string
 	confirmValueStateKey          = "confirmValue"        // value type: string
@@ -12,4 +12,13 @@ const (
 	podContainersStateKey         = "podContainers"       // value type: []string
 	logContainerStateKey          = "logContainer"        // value type: string
 	iniDefaultNamespaceKey        = "iniDefaultNamespace" // value type: string
+	detailRenderFuncStateKey      = "detailRenderFunc"    // value type: guilib.ViewHandler
+	configYAMLModeStateKey        = "configYAMLMode"      // value type: string
+	logPodStateKey                = "logPod"              // value type: string, format: "namespace/name"
+	logFilterStateKey             = "logFilter"           // value type: *logFilter
+	logRangeStateKey              = "logRange"            // value type: *logRange
+	logCursorsStateKey            = "logCursors"          // value type: logCursors
+	logScrollStateKey             = "logScroll"           // value type: int, lines from the top line to the bottom
+	deploymentComparisonStateKey  = "deploymentCompare"   // value type: *deploymentComparison
+	driftDiffStateKey             = "driftDiff"           // value type: *driftDiff
 )
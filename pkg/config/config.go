@@ -19,12 +19,13 @@ var (
 
 	DefaultConfig = &Config{
 		GuiConfig: &GuiConfig{
-			Highlight:  true,
-			Cursor:     false,
-			FgColor:    gocui.ColorWhite,
-			SelFgColor: gocui.ColorGreen,
-			Mouse:      true,
-			InputEsc:   true,
+			Highlight:      true,
+			Cursor:         false,
+			FgColor:        gocui.ColorWhite,
+			SelFgColor:     gocui.ColorGreen,
+			Mouse:          true,
+			InputEsc:       true,
+			ConfigYAMLMode: DefaultConfigYAMLMode,
 		},
 		LogConfig: &LogConfig{
 			Path:  path.Join(LazykubeHomePath, "log/"),
@@ -32,9 +33,15 @@ var (
 		},
 		UserConfig: &UserConfig{
 			CustomResourcePanels: []string{},
+			Logs:                 &LogsConfig{Tail: DefaultLogsTail},
+			Metrics: &MetricsConfig{
+				Interval:  DefaultMetricsInterval.String(),
+				Retention: DefaultMetricsRetention.String(),
+			},
 			History: &History{
-				ImageHistory:   []string{},
-				CommandHistory: []string{},
+				ImageHistory:        []string{},
+				CommandHistory:      []string{},
+				ManifestPathHistory: []string{},
 			},
 		},
 	}
@@ -2,14 +2,40 @@ package config
 
 import "github.com/jroimartin/gocui"
 
+const (
+	// ConfigYAMLModeRaw show the whole YAML of resource.
+	ConfigYAMLModeRaw = "raw"
+	// ConfigYAMLModeClean strip managedFields, status and other noise from YAML.
+	ConfigYAMLModeClean = "clean"
+	// ConfigYAMLModeStatus only show status of resource.
+	ConfigYAMLModeStatus = "status"
+
+	// DefaultConfigYAMLMode mode of Config view if it is not configured.
+	DefaultConfigYAMLMode = ConfigYAMLModeRaw
+)
+
+var (
+	// ConfigYAMLModes ConfigYAMLModes
+	ConfigYAMLModes = []string{ConfigYAMLModeClean, ConfigYAMLModeStatus, ConfigYAMLModeRaw}
+)
+
 // GuiConfig GuiConfig
 type GuiConfig struct {
-	Highlight  bool            `yaml:"highlight"`
-	Cursor     bool            `yaml:"cursor"`
-	FgColor    gocui.Attribute `yaml:"fg_color"`
-	BgColor    gocui.Attribute `yaml:"bg_color"`
-	SelBgColor gocui.Attribute `yaml:"sel_bg_color"`
-	SelFgColor gocui.Attribute `yaml:"sel_fg_color"`
-	Mouse      bool            `yaml:"mouse"`
-	InputEsc   bool            `yaml:"input_esc"`
+	Highlight      bool            `yaml:"highlight"`
+	Cursor         bool            `yaml:"cursor"`
+	FgColor        gocui.Attribute `yaml:"fg_color"`
+	BgColor        gocui.Attribute `yaml:"bg_color"`
+	SelBgColor     gocui.Attribute `yaml:"sel_bg_color"`
+	SelFgColor     gocui.Attribute `yaml:"sel_fg_color"`
+	Mouse          bool            `yaml:"mouse"`
+	InputEsc       bool            `yaml:"input_esc"`
+	ConfigYAMLMode string          `yaml:"config_yaml_mode"`
+}
+
+// GetConfigYAMLMode returns mode of Config view, default mode will be returned if it is not configured.
+func (c *GuiConfig) GetConfigYAMLMode() string {
+	if c.ConfigYAMLMode == "" {
+		return DefaultConfigYAMLMode
+	}
+	return c.ConfigYAMLMode
 }
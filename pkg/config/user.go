@@ -1,8 +1,132 @@
 package config
 
+import "time"
+
+const (
+	maxRecentNamespaces = 10
+
+	// DefaultLogsTail default lines of logs to show.
+	DefaultLogsTail int64 = 500
+
+	// DefaultMetricsInterval default interval of collecting pod metrics.
+	DefaultMetricsInterval = 15 * time.Second
+	// DefaultMetricsRetention default time range of pod metrics history.
+	DefaultMetricsRetention = 30 * time.Minute
+)
+
 type UserConfig struct {
-	CustomResourcePanels []string
-	History              *History `yaml:"history"`
+	CustomResourcePanels   []string
+	History                *History                      `yaml:"history"`
+	OptionalPanels         []string                      `yaml:"optional_panels"`
+	ContextNamespaces      map[string]*ContextNamespaces `yaml:"context_namespaces"`
+	OnlyFavoriteNamespaces bool                          `yaml:"only_favorite_namespaces"`
+	PersistContext         bool                          `yaml:"persist_context"`
+	CompareContexts        []string                      `yaml:"compare_contexts"`
+	Logs                   *LogsConfig                   `yaml:"logs"`
+	Metrics                *MetricsConfig                `yaml:"metrics"`
+}
+
+// LogsConfig default range of container logs, logs since "Since" will be shown if it is set, otherwise the last "Tail" lines.
+type LogsConfig struct {
+	Tail  int64  `yaml:"tail"`
+	Since string `yaml:"since"`
+}
+
+// GetLogsConfig returns logs config, it will be created with defaults if not existed.
+func (c *UserConfig) GetLogsConfig() *LogsConfig {
+	if c.Logs == nil {
+		c.Logs = &LogsConfig{Tail: DefaultLogsTail}
+	}
+	return c.Logs
+}
+
+// MetricsConfig pod metrics history, metrics will be collected every "Interval" and kept for "Retention".
+// History will be saved under lazykube home if "Persist" is true.
+type MetricsConfig struct {
+	Interval  string `yaml:"interval"`
+	Retention string `yaml:"retention"`
+	Persist   bool   `yaml:"persist"`
+}
+
+// GetMetricsConfig returns metrics config, it will be created with defaults if not existed.
+func (c *UserConfig) GetMetricsConfig() *MetricsConfig {
+	if c.Metrics == nil {
+		c.Metrics = &MetricsConfig{
+			Interval:  DefaultMetricsInterval.String(),
+			Retention: DefaultMetricsRetention.String(),
+		}
+	}
+	return c.Metrics
+}
+
+// IntervalDuration returns the interval, default interval will be returned if it is invalid.
+func (c *MetricsConfig) IntervalDuration() time.Duration {
+	return parsePositiveDuration(c.Interval, DefaultMetricsInterval)
+}
+
+// RetentionDuration returns the retention, default retention will be returned if it is invalid.
+func (c *MetricsConfig) RetentionDuration() time.Duration {
+	return parsePositiveDuration(c.Retention, DefaultMetricsRetention)
+}
+
+func parsePositiveDuration(value string, defaultValue time.Duration) time.Duration {
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return defaultValue
+	}
+	return duration
+}
+
+// ContextNamespaces favorite and recently used namespaces of a kubeconfig context.
+type ContextNamespaces struct {
+	Favorites []string `yaml:"favorites"`
+	Recents   []string `yaml:"recents"`
+}
+
+// GetContextNamespaces returns namespaces config of context, it will be created if not existed.
+func (c *UserConfig) GetContextNamespaces(context string) *ContextNamespaces {
+	if c.ContextNamespaces == nil {
+		c.ContextNamespaces = make(map[string]*ContextNamespaces)
+	}
+
+	namespaces, ok := c.ContextNamespaces[context]
+	if !ok || namespaces == nil {
+		namespaces = &ContextNamespaces{Favorites: []string{}, Recents: []string{}}
+		c.ContextNamespaces[context] = namespaces
+	}
+	return namespaces
+}
+
+func (n *ContextNamespaces) IsFavorite(namespace string) bool {
+	for _, each := range n.Favorites {
+		if each == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// ToggleFavorite add namespace to favorites or remove it, returns whether it is favorite now.
+func (n *ContextNamespaces) ToggleFavorite(namespace string) bool {
+	for index, each := range n.Favorites {
+		if each == namespace {
+			n.Favorites = append(n.Favorites[:index], n.Favorites[index+1:]...)
+			return false
+		}
+	}
+	n.Favorites = append(n.Favorites, namespace)
+	return true
+}
+
+// AddRecent move namespace to the front of recents.
+func (n *ContextNamespaces) AddRecent(namespace string) {
+	recents := []string{namespace}
+	for _, each := range n.Recents {
+		if each != namespace && len(recents) < maxRecentNamespaces {
+			recents = append(recents, each)
+		}
+	}
+	n.Recents = recents
 }
 
 func (c *UserConfig) AddCustomResourcePanels(resources ...string) {
@@ -16,6 +140,37 @@ func (c *UserConfig) AddCustomResourcePanels(resources ...string) {
 	}
 }
 
+// AddOptionalPanel add view name of optional function panel like helm releases or cronjobs.
+func (c *UserConfig) AddOptionalPanel(name string) {
+	for _, each := range c.OptionalPanels {
+		if each == name {
+			return
+		}
+	}
+	c.OptionalPanels = append(c.OptionalPanels, name)
+}
+
+// DeleteOptionalPanel delete view name of optional function panel.
+func (c *UserConfig) DeleteOptionalPanel(name string) {
+	for index, each := range c.OptionalPanels {
+		if each == name {
+			c.OptionalPanels = append(c.OptionalPanels[:index], c.OptionalPanels[index+1:]...)
+			return
+		}
+	}
+}
+
+// ToggleCompareContext add context to compare contexts or remove it.
+func (c *UserConfig) ToggleCompareContext(context string) {
+	for index, each := range c.CompareContexts {
+		if each == context {
+			c.CompareContexts = append(c.CompareContexts[:index], c.CompareContexts[index+1:]...)
+			return
+		}
+	}
+	c.CompareContexts = append(c.CompareContexts, context)
+}
+
 func (c *UserConfig) DeleteCustomResourcePanels(resources ...string) {
 	for _, resource := range resources {
 		for index, each := range c.CustomResourcePanels {
@@ -27,9 +182,10 @@ func (c *UserConfig) DeleteCustomResourcePanels(resources ...string) {
 }
 
 type History struct {
-	ImageHistory   []string `yaml:"image_history"`
-	CommandHistory []string `yaml:"command_history"`
-	PodNameHistory []string `yaml:"pod_name_history"`
+	ImageHistory        []string `yaml:"image_history"`
+	CommandHistory      []string `yaml:"command_history"`
+	PodNameHistory      []string `yaml:"pod_name_history"`
+	ManifestPathHistory []string `yaml:"manifest_path_history"`
 }
 
 func (h *History) AddStringHistory(history []string, newOne string) []string {
@@ -53,3 +209,7 @@ func (h *History) AddImageHistory(newOne string) {
 func (h *History) AddPodNameHistory(newOne string) {
 	h.PodNameHistory = h.AddStringHistory(h.PodNameHistory, newOne)
 }
+
+func (h *History) AddManifestPathHistory(newOne string) {
+	h.ManifestPathHistory = h.AddStringHistory(h.ManifestPathHistory, newOne)
+}
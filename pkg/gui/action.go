@@ -103,7 +103,7 @@ func ViewClickHandler(gui *Gui, view *View) error {
 	view, err := gui.GetView(viewName)
 	if err != nil {
 		if errors.Is(err, gocui.ErrUnknownView) {
-			log.Logger.Warningf("ViewClickHandler - gui.GetView(%s) error %+v", view, err)
+			log.Logger.Warningf("ViewClickHandler - gui.GetView(%s) error %+v", viewName, err)
 			return nil
 		}
 		return err
@@ -406,6 +406,9 @@ func (gui *Gui) renderView(view *View, x0, y0, x1, y1 int) error {
 	}
 
 	if view != nil {
+		if view.TitleFunc != nil && view.v != nil {
+			view.v.Title = view.TitleFunc(gui, view)
+		}
 		if err := view.render(); err != nil {
 			return err
 		}
@@ -0,0 +1,370 @@
+package gui
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	seriesPlotMaxPoints = 1024
+	seriesPoint         = "•"
+	plotLinePoint       = "╌"
+)
+
+// PlotLine horizontal line of SeriesPlot
+type PlotLine struct {
+	Name  string
+	Value float64
+	Color func(format string, a ...interface{}) string
+}
+
+// SeriesPlot plot multiple series with horizontal lines.
+type SeriesPlot struct {
+	Name   string
+	data   map[string][]float64
+	length int
+	since  time.Time
+
+	// Primary series will be drawn above others and be the first one of legend.
+	Primary     string
+	ValueFormat string
+	DataGetter  func() map[string]float64
+	Lines       func(plot *SeriesPlot) []PlotLine
+	Height      func(plot *SeriesPlot) int
+	Width       func(plot *SeriesPlot) int
+	Max         func(plot *SeriesPlot) float64
+	Min         func(plot *SeriesPlot) float64
+	Caption     func(plot *SeriesPlot) string
+	Color       func(series string) func(format string, a ...interface{}) string
+}
+
+// NewSeriesPlot NewSeriesPlot
+func NewSeriesPlot(
+	name string,
+	primary string,
+	valueFormat string,
+	dataGetter func() map[string]float64,
+	lines func(plot *SeriesPlot) []PlotLine,
+	height func(plot *SeriesPlot) int,
+	width func(plot *SeriesPlot) int,
+	max func(plot *SeriesPlot) float64,
+	min func(plot *SeriesPlot) float64,
+	caption func(plot *SeriesPlot) string,
+	color func(series string) func(format string, a ...interface{}) string,
+) *SeriesPlot {
+	return &SeriesPlot{
+		Name:        name,
+		data:        make(map[string][]float64),
+		since:       time.Now(),
+		Primary:     primary,
+		ValueFormat: valueFormat,
+		DataGetter:  dataGetter,
+		Lines:       lines,
+		Height:      height,
+		Width:       width,
+		Max:         max,
+		Min:         min,
+		Caption:     caption,
+		Color:       color,
+	}
+}
+
+// Append append values of series, missing series will be filled with NaN.
+// Series will be dropped once it has no value in the window of plot, e.g. series of deleted pods.
+func (plot *SeriesPlot) Append(values map[string]float64) {
+	window := seriesPlotMaxPoints
+	if plot.Width != nil {
+		window = plot.Width(plot)
+	}
+
+	for name := range values {
+		if _, ok := plot.data[name]; !ok {
+			plot.data[name] = nanSeries(plot.length)
+		}
+	}
+	for name, data := range plot.data {
+		value, ok := values[name]
+		if !ok {
+			value = math.NaN()
+		}
+		data = append(data, value)
+		if len(data) > seriesPlotMaxPoints {
+			data = data[len(data)-seriesPlotMaxPoints:]
+		}
+		if noValue(data, window) {
+			delete(plot.data, name)
+			continue
+		}
+		plot.data[name] = data
+	}
+	plot.length++
+	if plot.length > seriesPlotMaxPoints {
+		plot.length = seriesPlotMaxPoints
+	}
+}
+
+// Series returns names of series, primary series is the first one.
+func (plot *SeriesPlot) Series() []string {
+	names := make([]string, 0, len(plot.data))
+	for name := range plot.data {
+		if name == plot.Primary {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if _, ok := plot.data[plot.Primary]; ok {
+		names = append([]string{plot.Primary}, names...)
+	}
+	return names
+}
+
+// Data returns data of series.
+func (plot *SeriesPlot) Data(series string) []float64 {
+	return plot.data[series]
+}
+
+// Last returns the last value of series, NaN if not present.
+func (plot *SeriesPlot) Last(series string) float64 {
+	data := plot.data[series]
+	if len(data) == 0 {
+		return math.NaN()
+	}
+	return data[len(data)-1]
+}
+
+// Len Len
+func (plot *SeriesPlot) Len() int {
+	return plot.length
+}
+
+// Since Since
+func (plot *SeriesPlot) Since() time.Time {
+	return plot.since
+}
+
+// MaxValue returns the max value of all series and lines.
+func (plot *SeriesPlot) MaxValue() float64 {
+	max := math.Inf(-1)
+	for _, data := range plot.data {
+		for _, value := range data {
+			if !math.IsNaN(value) {
+				max = math.Max(max, value)
+			}
+		}
+	}
+	for _, line := range plot.lines() {
+		max = math.Max(max, line.Value)
+	}
+	if math.IsInf(max, -1) {
+		return 0
+	}
+	return max
+}
+
+// MinValue returns the min value of all series and lines.
+func (plot *SeriesPlot) MinValue() float64 {
+	min := math.Inf(1)
+	for _, data := range plot.data {
+		for _, value := range data {
+			if !math.IsNaN(value) {
+				min = math.Min(min, value)
+			}
+		}
+	}
+	for _, line := range plot.lines() {
+		min = math.Min(min, line.Value)
+	}
+	if math.IsInf(min, 1) {
+		return 0
+	}
+	return min
+}
+
+func (plot *SeriesPlot) lines() []PlotLine {
+	if plot.Lines == nil {
+		return nil
+	}
+	return plot.Lines(plot)
+}
+
+func (plot *SeriesPlot) color(series string) func(format string, a ...interface{}) string {
+	if plot.Color == nil {
+		return fmt.Sprintf
+	}
+	return plot.Color(series)
+}
+
+func (plot *SeriesPlot) formatValue(value float64) string {
+	if math.IsNaN(value) {
+		return "-"
+	}
+	return fmt.Sprintf(plot.ValueFormat, value)
+}
+
+// Graph Graph
+func (plot *SeriesPlot) Graph() string {
+	height := plot.Height(plot)
+	if height < 2 {
+		height = 2
+	}
+	max, min := plot.Max(plot), plot.Min(plot)
+	if max <= min {
+		max = min + 1
+	}
+
+	labels := make([]string, height)
+	labelWidth := 0
+	for row := range labels {
+		labels[row] = fmt.Sprintf("%.0f", max-(max-min)*float64(row)/float64(height-1))
+		if len(labels[row]) > labelWidth {
+			labelWidth = len(labels[row])
+		}
+	}
+
+	width := plot.Width(plot) - labelWidth - 2
+	if width < 1 {
+		width = 1
+	}
+
+	rowOf := func(value float64) int {
+		return height - 1 - int(math.Round((value-min)/(max-min)*float64(height-1)))
+	}
+
+	grid := make([][]string, height)
+	for row := range grid {
+		grid[row] = make([]string, width)
+		for col := range grid[row] {
+			grid[row][col] = " "
+		}
+	}
+
+	lines := plot.lines()
+	for _, line := range lines {
+		row := rowOf(line.Value)
+		if row < 0 || row >= height {
+			continue
+		}
+		for col := range grid[row] {
+			grid[row][col] = line.Color(plotLinePoint)
+		}
+	}
+
+	// Draw primary series at last so that it is above others.
+	series := plot.Series()
+	for i := len(series) - 1; i >= 0; i-- {
+		data := plot.data[series[i]]
+		if len(data) > width {
+			data = data[len(data)-width:]
+		}
+		offset := width - len(data)
+		sprintf := plot.color(series[i])
+		for index, value := range data {
+			if math.IsNaN(value) {
+				continue
+			}
+			row := rowOf(value)
+			if row < 0 || row >= height {
+				continue
+			}
+			grid[row][offset+index] = sprintf(seriesPoint)
+		}
+	}
+
+	var builder strings.Builder
+	for row := range grid {
+		fmt.Fprintf(&builder, "%*s ┤%s\n", labelWidth, labels[row], strings.Join(grid[row], ""))
+	}
+	if plot.Caption != nil {
+		fmt.Fprintf(&builder, "%*s  %s\n", labelWidth, "", plot.Caption(plot))
+	}
+
+	legends := make([]string, 0, len(series)+len(lines))
+	legendWidths := make([]int, 0, cap(legends))
+	for _, name := range series {
+		text := fmt.Sprintf(" %s %s", name, plot.formatValue(plot.Last(name)))
+		legends = append(legends, plot.color(name)(seriesPoint)+text)
+		legendWidths = append(legendWidths, utf8.RuneCountInString(seriesPoint+text))
+	}
+	for _, line := range lines {
+		text := fmt.Sprintf(" %s %s", line.Name, plot.formatValue(line.Value))
+		legends = append(legends, line.Color(plotLinePoint)+text)
+		legendWidths = append(legendWidths, utf8.RuneCountInString(plotLinePoint+text))
+	}
+	writeLegends(&builder, legends, legendWidths, labelWidth+2, labelWidth+2+width)
+	return builder.String()
+}
+
+func writeLegends(builder *strings.Builder, legends []string, widths []int, indent, maxWidth int) {
+	lineWidth := 0
+	for index, legend := range legends {
+		if lineWidth > 0 && lineWidth+2+widths[index] > maxWidth {
+			builder.WriteString("\n")
+			lineWidth = 0
+		}
+		if lineWidth == 0 {
+			builder.WriteString(strings.Repeat(" ", indent))
+			lineWidth = indent
+		} else {
+			builder.WriteString("  ")
+			lineWidth += 2
+		}
+		builder.WriteString(legend)
+		lineWidth += widths[index]
+	}
+	if lineWidth > 0 {
+		builder.WriteString("\n")
+	}
+}
+
+// Load replace data of plot with history values since the given time.
+func (plot *SeriesPlot) Load(history []map[string]float64, since time.Time) {
+	plot.data = make(map[string][]float64)
+	plot.length = 0
+	plot.since = since
+	for _, values := range history {
+		plot.Append(values)
+	}
+}
+
+// Render Render
+func (plot *SeriesPlot) Render(io io.Writer) {
+	plot.Append(plot.DataGetter())
+	plot.Write(io)
+}
+
+// Write write graph of current data.
+func (plot *SeriesPlot) Write(io io.Writer) {
+	if len(plot.data) == 0 {
+		_, _ = fmt.Fprintf(io, "%s - No data. ", plot.Name)
+		return
+	}
+
+	_, _ = fmt.Fprint(io, plot.Graph())
+}
+
+func nanSeries(length int) []float64 {
+	data := make([]float64, length)
+	for index := range data {
+		data[index] = math.NaN()
+	}
+	return data
+}
+
+// noValue check if the last window points of data are all NaN.
+func noValue(data []float64, window int) bool {
+	if window > 0 && len(data) > window {
+		data = data[len(data)-window:]
+	}
+	for _, value := range data {
+		if !math.IsNaN(value) {
+			return false
+		}
+	}
+	return true
+}
@@ -34,6 +34,7 @@ type (
 		Actions               []ActionInterface
 		Name                  string
 		Title                 string
+		TitleFunc             func(gui *Gui, view *View) string
 		SelectedLine          string
 		OnClick               ViewHandler
 		OnLineClick           func(gui *Gui, view *View, cy int, lineString string) error
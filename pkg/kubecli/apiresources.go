@@ -1,11 +1,99 @@
 package kubecli
 
 import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/kubectl/pkg/cmd/apiresources"
+	"sort"
+	"strings"
 )
 
 func (cli *KubeCLI) APIResources(streams genericclioptions.IOStreams, args ...string) *Cmd {
 	cmd := apiresources.NewCmdAPIResources(cli.factory, streams)
 	return NewCmd(cmd, args, streams)
 }
+
+// APIResource resource which the server offers.
+type APIResource struct {
+	Name       string
+	ShortNames []string
+	Group      string
+	Version    string
+	Namespaced bool
+	Kind       string
+	Verbs      []string
+}
+
+// FullName returns fully qualified resource name like "deployments.v1.apps".
+func (resource *APIResource) FullName() string {
+	if resource.Group == "" {
+		return resource.Name
+	}
+	return resource.Name + "." + resource.Version + "." + resource.Group
+}
+
+// GroupResource returns resource name qualified by API group like "deployments.apps".
+func (resource *APIResource) GroupResource() string {
+	if resource.Group == "" {
+		return resource.Name
+	}
+	return resource.Name + "." + resource.Group
+}
+
+// HasVerb returns whether the resource supports verb like "list".
+func (resource *APIResource) HasVerb(verb string) bool {
+	for _, each := range resource.Verbs {
+		if each == verb {
+			return true
+		}
+	}
+	return false
+}
+
+// ListAPIResources returns preferred version of resources which the server offers, like "kubectl api-resources".
+func (cli *KubeCLI) ListAPIResources() ([]*APIResource, error) {
+	discoveryClient, err := cli.factory.ToDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: Partial results will be returned even if some groups are unavailable.
+	lists, err := discoveryClient.ServerPreferredResources()
+	if len(lists) == 0 && err != nil {
+		return nil, err
+	}
+
+	resources := make([]*APIResource, 0)
+	for _, list := range lists {
+		if list == nil {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range list.APIResources {
+			// Skip subresources like "pods/log".
+			if len(resource.Verbs) == 0 || strings.Contains(resource.Name, "/") {
+				continue
+			}
+			resources = append(resources, &APIResource{
+				Name:       resource.Name,
+				ShortNames: resource.ShortNames,
+				Group:      gv.Group,
+				Version:    gv.Version,
+				Namespaced: resource.Namespaced,
+				Kind:       resource.Kind,
+				Verbs:      resource.Verbs,
+			})
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].Group != resources[j].Group {
+			return resources[i].Group < resources[j].Group
+		}
+		return resources[i].Name < resources[j].Name
+	})
+	return resources, nil
+}
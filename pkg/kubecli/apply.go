@@ -0,0 +1,11 @@
+package kubecli
+
+import (
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/kubectl/pkg/cmd/apply"
+)
+
+func (cli *KubeCLI) Apply(streams genericclioptions.IOStreams, args ...string) *Cmd {
+	cmd := apply.NewCmdApply("kubectl", cli.factory, streams)
+	return NewCmd(cmd, args, streams)
+}
@@ -0,0 +1,14 @@
+package kubecli
+
+import (
+	"k8s.io/client-go/kubernetes"
+)
+
+// ClientSet returns typed kubernetes client of current context.
+func (cli *KubeCLI) ClientSet() (*kubernetes.Clientset, error) {
+	config, err := cli.factory.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(config)
+}
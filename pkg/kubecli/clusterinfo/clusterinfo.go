@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/gookit/color"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/cli-runtime/pkg/resource"
 	"k8s.io/kubectl/pkg/cmd/util"
@@ -81,3 +82,47 @@ func ClusterInfo(factory util.Factory) (string, error) {
 	}
 	return strings.Join(infoArr, "\n"), nil
 }
+
+// URLsOfIngress returns urls of rules of ingress, load balancer address will be used if host of rule is empty.
+func URLsOfIngress(ingress *networkingv1.Ingress) []string {
+	address := ""
+	if len(ingress.Status.LoadBalancer.Ingress) > 0 {
+		address = ingress.Status.LoadBalancer.Ingress[0].IP
+		if address == "" {
+			address = ingress.Status.LoadBalancer.Ingress[0].Hostname
+		}
+	}
+
+	tlsHosts := make(map[string]bool)
+	for _, tls := range ingress.Spec.TLS {
+		for _, host := range tls.Hosts {
+			tlsHosts[host] = true
+		}
+	}
+
+	urls := make([]string, 0)
+	for _, rule := range ingress.Spec.Rules {
+		host := rule.Host
+		if host == "" {
+			host = address
+		}
+		if host == "" {
+			continue
+		}
+		schemeStr := "http"
+		if tlsHosts[rule.Host] {
+			schemeStr = "https"
+		}
+		if rule.HTTP == nil {
+			urls = append(urls, schemeStr+"://"+host)
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			urls = append(urls, schemeStr+"://"+host+path.Path)
+		}
+	}
+	if len(urls) == 0 && address != "" {
+		urls = append(urls, "http://"+address)
+	}
+	return urls
+}
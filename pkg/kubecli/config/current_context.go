@@ -3,15 +3,25 @@ package config
 import (
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sort"
 )
 
 var (
-	config *clientcmdapi.Config
+	config      *clientcmdapi.Config
+	pathOptions *clientcmd.PathOptions
 )
 
+// Context context of kubeconfig.
+type Context struct {
+	Name      string
+	Cluster   string
+	User      string
+	Namespace string
+}
+
 func init() {
 	var err error
-	pathOptions := clientcmd.NewDefaultPathOptions()
+	pathOptions = clientcmd.NewDefaultPathOptions()
 	config, err = pathOptions.GetStartingConfig()
 	if err != nil {
 		panic(err)
@@ -26,6 +36,18 @@ func SetCurrentContext(context string) {
 	config.CurrentContext = context
 }
 
+// PersistCurrentContext write current context back to kubeconfig like "kubectl config use-context".
+// Note: When KUBECONFIG contains multiple files, it will be written to the file which already defined current-context, or the first existing one.
+func PersistCurrentContext() error {
+	// Reload kubeconfig to avoid overwriting changes which made by others after lazykube started.
+	latest, err := pathOptions.GetStartingConfig()
+	if err != nil {
+		return err
+	}
+	latest.CurrentContext = config.CurrentContext
+	return clientcmd.ModifyConfig(pathOptions, *latest, true)
+}
+
 func ContextNamespace() string {
 	ctx, ok := config.Contexts[config.CurrentContext]
 	if !ok {
@@ -40,5 +62,20 @@ func ListContexts() []string {
 	for name := range config.Contexts {
 		contexts = append(contexts, name)
 	}
+	sort.Strings(contexts)
 	return contexts
 }
+
+// GetContext returns cluster, user and namespace of context.
+func GetContext(name string) (*Context, bool) {
+	ctx, ok := config.Contexts[name]
+	if !ok {
+		return nil, false
+	}
+	return &Context{
+		Name:      name,
+		Cluster:   ctx.Cluster,
+		User:      ctx.AuthInfo,
+		Namespace: ctx.Namespace,
+	}, true
+}
@@ -0,0 +1,31 @@
+package kubecli
+
+import (
+	"context"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (cli *KubeCLI) GetPod(namespace, name string) (*v1.Pod, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+	return client.CoreV1().Pods(namespace).Get(context.Background(), name, metav1.GetOptions{})
+}
+
+// PreviousLogs returns the last tail lines of the previous terminated instance of container, like "kubectl logs --previous".
+func (cli *KubeCLI) PreviousLogs(namespace, pod, container string, tail int64) (string, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return "", err
+	}
+
+	raw, err := client.CoreV1().Pods(namespace).
+		GetLogs(pod, &v1.PodLogOptions{Container: container, Previous: true, TailLines: &tail}).
+		DoRaw(context.Background())
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
@@ -0,0 +1,138 @@
+package kubecli
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sort"
+)
+
+var (
+	// Note: apiextensions.k8s.io/v1beta1 was removed by new clusters.
+	crdResources = []string{
+		"customresourcedefinitions.v1.apiextensions.k8s.io",
+		"customresourcedefinitions.v1beta1.apiextensions.k8s.io",
+	}
+)
+
+// PrinterColumn additional printer column of custom resource definition.
+type PrinterColumn struct {
+	Name     string
+	Type     string
+	JSONPath string
+	Priority int64
+}
+
+// CustomResourceDefinition custom resource definition.
+type CustomResourceDefinition struct {
+	Name           string
+	Group          string
+	Kind           string
+	Plural         string
+	Namespaced     bool
+	Versions       []string
+	PrinterColumns []*PrinterColumn
+}
+
+// Resource returns the fully qualified resource name like "certificates.cert-manager.io".
+func (crd *CustomResourceDefinition) Resource() string {
+	return crd.Plural + "." + crd.Group
+}
+
+// ListCustomResourceDefinitions returns custom resource definitions sorted by group and kind.
+func (cli *KubeCLI) ListCustomResourceDefinitions() ([]*CustomResourceDefinition, error) {
+	var items []*unstructured.Unstructured
+	var err error
+	for _, resource := range crdResources {
+		items, err = cli.ListUnstructured(resource, "")
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	crds := make([]*CustomResourceDefinition, 0, len(items))
+	for _, item := range items {
+		crds = append(crds, newCustomResourceDefinition(item))
+	}
+	sort.Slice(crds, func(i, j int) bool {
+		if crds[i].Group != crds[j].Group {
+			return crds[i].Group < crds[j].Group
+		}
+		return crds[i].Kind < crds[j].Kind
+	})
+	return crds, nil
+}
+
+// GetCustomResourceDefinition returns custom resource definition by resource name like "certificates.cert-manager.io" or "certificates", returns nil if not found.
+func (cli *KubeCLI) GetCustomResourceDefinition(resource string) (*CustomResourceDefinition, error) {
+	crds, err := cli.ListCustomResourceDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	return FindCustomResourceDefinition(crds, resource), nil
+}
+
+// FindCustomResourceDefinition finds custom resource definition by resource name like "certificates.cert-manager.io" or "certificates".
+// It returns nil if not found, or the plural name is ambiguous because more than one API group defined it.
+func FindCustomResourceDefinition(crds []*CustomResourceDefinition, resource string) *CustomResourceDefinition {
+	var found *CustomResourceDefinition
+	for _, crd := range crds {
+		if crd.Resource() == resource || crd.Name == resource {
+			return crd
+		}
+		if crd.Plural == resource {
+			if found != nil {
+				return nil
+			}
+			found = crd
+		}
+	}
+	return found
+}
+
+func newCustomResourceDefinition(obj *unstructured.Unstructured) *CustomResourceDefinition {
+	crd := &CustomResourceDefinition{Name: obj.GetName()}
+	crd.Group, _, _ = unstructured.NestedString(obj.Object, "spec", "group")
+	crd.Kind, _, _ = unstructured.NestedString(obj.Object, "spec", "names", "kind")
+	crd.Plural, _, _ = unstructured.NestedString(obj.Object, "spec", "names", "plural")
+	scope, _, _ := unstructured.NestedString(obj.Object, "spec", "scope")
+	crd.Namespaced = scope == "Namespaced"
+
+	// apiextensions.k8s.io/v1beta1
+	columns, _, _ := unstructured.NestedSlice(obj.Object, "spec", "additionalPrinterColumns")
+
+	versions, _, _ := unstructured.NestedSlice(obj.Object, "spec", "versions")
+	for _, each := range versions {
+		version, ok := each.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(version, "name")
+		crd.Versions = append(crd.Versions, name)
+
+		// apiextensions.k8s.io/v1, use columns of storage version.
+		if storage, _, _ := unstructured.NestedBool(version, "storage"); storage {
+			if versionColumns, found, _ := unstructured.NestedSlice(version, "additionalPrinterColumns"); found {
+				columns = versionColumns
+			}
+		}
+	}
+
+	for _, each := range columns {
+		column, ok := each.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		printerColumn := &PrinterColumn{}
+		printerColumn.Name, _, _ = unstructured.NestedString(column, "name")
+		printerColumn.Type, _, _ = unstructured.NestedString(column, "type")
+		printerColumn.Priority, _, _ = unstructured.NestedInt64(column, "priority")
+		printerColumn.JSONPath, _, _ = unstructured.NestedString(column, "jsonPath")
+		if printerColumn.JSONPath == "" {
+			printerColumn.JSONPath, _, _ = unstructured.NestedString(column, "JSONPath")
+		}
+		crd.PrinterColumns = append(crd.PrinterColumns, printerColumn)
+	}
+	return crd
+}
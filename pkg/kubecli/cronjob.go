@@ -0,0 +1,215 @@
+package kubecli
+
+import (
+	"context"
+	"fmt"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"sort"
+)
+
+const (
+	cronJobKind                  = "CronJob"
+	cronJobInstantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+	manualJobNameMaxLength       = 63
+	jobNameLabel                 = "job-name"
+
+	JobStatusComplete  = "Complete"
+	JobStatusFailed    = "Failed"
+	JobStatusSuspended = "Suspended"
+	JobStatusRunning   = "Running"
+)
+
+// TriggerCronJob create a job from the template of cronjob like "kubectl create job --from=cronjob/name".
+func (cli *KubeCLI) TriggerCronJob(ctx context.Context, namespace, name string) (*batchv1.Job, error) {
+	cronJob, err := cli.WithNamespace(namespace).GetUnstructured("cronjobs", name)
+	if err != nil {
+		return nil, err
+	}
+
+	template, found, err := unstructured.NestedMap(cronJob.Object, "spec", "jobTemplate")
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("job template of cronjob '%s' not found", name)
+	}
+
+	jobTemplate := &batchv1beta1.JobTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(template, jobTemplate); err != nil {
+		return nil, err
+	}
+
+	annotations := map[string]string{cronJobInstantiateAnnotation: "manual"}
+	for key, value := range jobTemplate.Annotations {
+		annotations[key] = value
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            manualJobName(name),
+			Namespace:       cronJob.GetNamespace(),
+			Labels:          jobTemplate.Labels,
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cronJob, cronJob.GroupVersionKind())},
+		},
+		Spec: jobTemplate.Spec,
+	}
+
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+	return client.BatchV1().Jobs(cronJob.GetNamespace()).Create(ctx, job, metav1.CreateOptions{})
+}
+
+// CronJobSuspended returns whether the cronjob is suspended.
+func (cli *KubeCLI) CronJobSuspended(namespace, name string) (bool, error) {
+	cronJob, err := cli.WithNamespace(namespace).GetUnstructured("cronjobs", name)
+	if err != nil {
+		return false, err
+	}
+
+	suspend, _, err := unstructured.NestedBool(cronJob.Object, "spec", "suspend")
+	if err != nil {
+		return false, err
+	}
+	return suspend, nil
+}
+
+// ListCronJobJobs returns jobs controlled by the cronjob, the latest job is the first.
+func (cli *KubeCLI) ListCronJobJobs(ctx context.Context, namespace, name string) ([]batchv1.Job, error) {
+	cronJob, err := cli.WithNamespace(namespace).GetUnstructured("cronjobs", name)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: Jobs can not be listed by owner, they are listed by labels of job template at least.
+	templateLabels, _, err := unstructured.NestedStringMap(cronJob.Object, "spec", "jobTemplate", "metadata", "labels")
+	if err != nil {
+		return nil, err
+	}
+	jobList, err := client.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(templateLabels).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]batchv1.Job, 0)
+	for _, job := range jobList.Items {
+		owner := metav1.GetControllerOf(&job)
+		if owner == nil || owner.Kind != cronJobKind || owner.UID != cronJob.GetUID() {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[j].CreationTimestamp.Before(&jobs[i].CreationTimestamp)
+	})
+	return jobs, nil
+}
+
+// ListJobsPods returns pods of jobs in namespace by job name, pods are listed at once by "job-name" label.
+func (cli *KubeCLI) ListJobsPods(ctx context.Context, namespace string, jobs []batchv1.Job) (map[string][]v1.Pod, error) {
+	result := make(map[string][]v1.Pod)
+	if len(jobs) == 0 {
+		return result, nil
+	}
+
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		names = append(names, job.Name)
+	}
+	requirement, err := labels.NewRequirement(jobNameLabel, selection.In, names)
+	if err != nil {
+		return nil, err
+	}
+
+	podList, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.NewSelector().Add(*requirement).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range podList.Items {
+		jobName := pod.Labels[jobNameLabel]
+		result[jobName] = append(result[jobName], pod)
+	}
+	return result, nil
+}
+
+// DeleteFinishedCronJobJobs delete completed and failed jobs of the cronjob, returns names of deleted jobs.
+func (cli *KubeCLI) DeleteFinishedCronJobJobs(ctx context.Context, namespace, name string) ([]string, error) {
+	jobs, err := cli.ListCronJobJobs(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	propagationPolicy := metav1.DeletePropagationBackground
+	deleted := make([]string, 0)
+	for _, job := range jobs {
+		if status := JobStatus(&job); status != JobStatusComplete && status != JobStatusFailed {
+			continue
+		}
+
+		if err := client.BatchV1().Jobs(job.Namespace).Delete(
+			ctx,
+			job.Name,
+			metav1.DeleteOptions{PropagationPolicy: &propagationPolicy},
+		); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, job.Name)
+	}
+	return deleted, nil
+}
+
+// JobStatus returns "Complete", "Failed", "Suspended" or "Running" by conditions of job.
+func JobStatus(job *batchv1.Job) string {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return JobStatusComplete
+		case batchv1.JobFailed:
+			return JobStatusFailed
+		case "Suspended":
+			return JobStatusSuspended
+		}
+	}
+	return JobStatusRunning
+}
+
+func manualJobName(cronJobName string) string {
+	suffix := "-manual-" + rand.String(5)
+	if len(cronJobName)+len(suffix) > manualJobNameMaxLength {
+		cronJobName = cronJobName[:manualJobNameMaxLength-len(suffix)]
+	}
+	return cronJobName + suffix
+}
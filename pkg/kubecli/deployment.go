@@ -0,0 +1,90 @@
+package kubecli
+
+import (
+	"context"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sync"
+	"time"
+)
+
+const compareTimeout = 5 * time.Second
+
+// DeploymentSummary image, replicas and status of deployment in a context.
+type DeploymentSummary struct {
+	Context   string
+	Images    []string
+	Replicas  int32
+	Ready     int32
+	Updated   int32
+	Available int32
+	Status    string
+	Err       error
+}
+
+// CompareDeployment get the same deployment from contexts concurrently, results are in the order of contexts.
+// Contexts should include the current context explicitly, it is safe to be called in background.
+func CompareDeployment(contexts []string, namespace, name string) []*DeploymentSummary {
+	summaries := make([]*DeploymentSummary, len(contexts))
+	var wg sync.WaitGroup
+	for index, ctx := range contexts {
+		wg.Add(1)
+		go func(index int, ctx string) {
+			defer wg.Done()
+			summaries[index] = ContextCli(ctx).GetDeploymentSummary(namespace, name)
+			summaries[index].Context = ctx
+		}(index, ctx)
+	}
+	wg.Wait()
+	return summaries
+}
+
+func (cli *KubeCLI) GetDeploymentSummary(namespace, name string) *DeploymentSummary {
+	summary := &DeploymentSummary{}
+	client, err := cli.ClientSet()
+	if err != nil {
+		summary.Err = err
+		return summary
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), compareTimeout)
+	defer cancel()
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		summary.Err = err
+		return summary
+	}
+
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		summary.Images = append(summary.Images, container.Image)
+	}
+	if deployment.Spec.Replicas != nil {
+		summary.Replicas = *deployment.Spec.Replicas
+	}
+	summary.Ready = deployment.Status.ReadyReplicas
+	summary.Updated = deployment.Status.UpdatedReplicas
+	summary.Available = deployment.Status.AvailableReplicas
+	summary.Status = deploymentStatus(deployment)
+	return summary
+}
+
+// deploymentStatus returns reason of the failed or progressing condition, like "kubectl rollout status".
+func deploymentStatus(deployment *appsv1.Deployment) string {
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == v1.ConditionTrue {
+			return condition.Reason
+		}
+		if condition.Type == appsv1.DeploymentProgressing && condition.Status == v1.ConditionFalse {
+			return condition.Reason
+		}
+	}
+
+	if deployment.Spec.Replicas != nil && deployment.Status.UpdatedReplicas < *deployment.Spec.Replicas {
+		return "Progressing"
+	}
+	if deployment.Status.AvailableReplicas < deployment.Status.Replicas {
+		return "Unavailable"
+	}
+	return "Available"
+}
@@ -0,0 +1,50 @@
+package kubecli
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/kubectl/pkg/cmd/apply"
+	"k8s.io/kubectl/pkg/cmd/diff"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/utils/exec"
+)
+
+// Note: Copy code because of kubectl diff calling "os.Exit" when differences were found.
+
+func NewCmdDiff(f cmdutil.Factory, streams genericclioptions.IOStreams) *cobra.Command {
+	options := diff.NewDiffOptions(streams)
+	cmd := &cobra.Command{
+		Use:                   "diff -f FILENAME",
+		DisableFlagsInUseLine: true,
+		Short:                 i18n.T("Diff live version against would-be applied version"),
+		Long:                  "Diff configurations specified by filename between the current online configuration, and the configuration as it would be if applied.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckDiffErr(options.Complete(f, cmd))
+			if len(args) != 0 {
+				cmdutil.CheckDiffErr(cmdutil.UsageErrorf(cmd, "Unexpected args: %v", args))
+			}
+			if err := options.Run(); err != nil {
+				// Note: Exit status 1 of diff program means that changes were found.
+				if exitErr, ok := err.(exec.ExitError); ok && exitErr.ExitStatus() <= 1 {
+					return
+				}
+				cmdutil.CheckDiffErr(err)
+			}
+		},
+	}
+
+	usage := "contains the configuration to diff"
+	cmd.Flags().StringVarP(&options.Selector, "selector", "l", options.Selector, "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	cmdutil.AddFilenameOptionFlags(cmd, &options.FilenameOptions, usage)
+	cmdutil.AddServerSideApplyFlags(cmd)
+	cmdutil.AddFieldManagerFlagVar(cmd, &options.FieldManager, apply.FieldManagerClientSideApply)
+
+	return cmd
+}
+
+// Diff Diff
+func (cli *KubeCLI) Diff(streams genericclioptions.IOStreams, args ...string) *Cmd {
+	cmd := NewCmdDiff(cli.factory, streams)
+	return NewCmd(cmd, args, streams)
+}
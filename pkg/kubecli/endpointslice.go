@@ -0,0 +1,103 @@
+package kubecli
+
+import (
+	"fmt"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sort"
+)
+
+const (
+	serviceNameLabel = "kubernetes.io/service-name"
+)
+
+var (
+	// Note: discovery.k8s.io/v1 is not supported by old clusters and discovery.k8s.io/v1beta1 was removed by new clusters.
+	endpointSliceResources = []string{
+		"endpointslices.v1.discovery.k8s.io",
+		"endpointslices.v1beta1.discovery.k8s.io",
+	}
+)
+
+// Endpoint an endpoint of endpoint slice.
+type Endpoint struct {
+	Addresses []string
+	Ready     bool
+	Pod       string
+	Node      string
+}
+
+// EndpointSlice endpoint slice of service.
+type EndpointSlice struct {
+	Name        string
+	AddressType string
+	Ports       []string
+	Endpoints   []*Endpoint
+}
+
+// ListServiceEndpointSlices returns endpoint slices of the service.
+func (cli *KubeCLI) ListServiceEndpointSlices(namespace, service string) ([]*EndpointSlice, error) {
+	var items []*unstructured.Unstructured
+	var err error
+	for _, resource := range endpointSliceResources {
+		items, err = cli.WithNamespace(namespace).ListUnstructured(resource, fmt.Sprintf("%s=%s", serviceNameLabel, service))
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	slices := make([]*EndpointSlice, 0, len(items))
+	for _, item := range items {
+		slices = append(slices, newEndpointSlice(item))
+	}
+	sort.Slice(slices, func(i, j int) bool {
+		return slices[i].Name < slices[j].Name
+	})
+	return slices, nil
+}
+
+func newEndpointSlice(obj *unstructured.Unstructured) *EndpointSlice {
+	slice := &EndpointSlice{Name: obj.GetName()}
+	slice.AddressType, _, _ = unstructured.NestedString(obj.Object, "addressType")
+
+	ports, _, _ := unstructured.NestedSlice(obj.Object, "ports")
+	for _, each := range ports {
+		port, ok := each.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(port, "name")
+		number, _, _ := unstructured.NestedInt64(port, "port")
+		protocol, _, _ := unstructured.NestedString(port, "protocol")
+		if name == "" {
+			slice.Ports = append(slice.Ports, fmt.Sprintf("%d/%s", number, protocol))
+			continue
+		}
+		slice.Ports = append(slice.Ports, fmt.Sprintf("%s:%d/%s", name, number, protocol))
+	}
+
+	endpoints, _, _ := unstructured.NestedSlice(obj.Object, "endpoints")
+	for _, each := range endpoints {
+		raw, ok := each.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		endpoint := &Endpoint{Ready: true}
+		endpoint.Addresses, _, _ = unstructured.NestedStringSlice(raw, "addresses")
+		// Note: Nil ready condition should be interpreted as "true".
+		if ready, found, _ := unstructured.NestedBool(raw, "conditions", "ready"); found {
+			endpoint.Ready = ready
+		}
+		if kind, _, _ := unstructured.NestedString(raw, "targetRef", "kind"); kind == "Pod" {
+			endpoint.Pod, _, _ = unstructured.NestedString(raw, "targetRef", "name")
+		}
+		endpoint.Node, _, _ = unstructured.NestedString(raw, "nodeName")
+		if endpoint.Node == "" {
+			endpoint.Node, _, _ = unstructured.NestedString(raw, "topology", "kubernetes.io/hostname")
+		}
+		slice.Endpoints = append(slice.Endpoints, endpoint)
+	}
+	return slice
+}
@@ -0,0 +1,12 @@
+package kubecli
+
+import (
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/kubectl/pkg/cmd/explain"
+)
+
+// Explain Explain
+func (cli *KubeCLI) Explain(streams genericclioptions.IOStreams, args ...string) *Cmd {
+	cmd := explain.NewCmdExplain("kubectl", cli.factory, streams)
+	return NewCmd(cmd, args, streams)
+}
@@ -5,15 +5,10 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
 )
 
 func (cli *KubeCLI) GetNamespaces(ctx context.Context, opts metav1.ListOptions) (*v1.NamespaceList, error) {
-	config, err := cli.factory.ToRESTConfig()
-	if err != nil {
-		return nil, err
-	}
-	client, err := kubernetes.NewForConfig(config)
+	client, err := cli.ClientSet()
 	if err != nil {
 		return nil, err
 	}
@@ -21,7 +16,12 @@ func (cli *KubeCLI) GetNamespaces(ctx context.Context, opts metav1.ListOptions)
 }
 
 func (cli *KubeCLI) HasNamespacePermission(ctx context.Context) bool {
-	_, err := cli.GetNamespaces(ctx, metav1.ListOptions{})
+	allowed, err := cli.CanI(ctx, "", "list", "namespaces", "")
+	if err == nil {
+		return allowed
+	}
+
+	_, err = cli.GetNamespaces(ctx, metav1.ListOptions{})
 	if err == nil {
 		return true
 	}
@@ -10,8 +10,17 @@ import (
 	metricsapi "k8s.io/metrics/pkg/apis/metrics"
 	metricsv1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
+	"time"
 )
 
+// PodContainersMetrics usages of containers of pod sampled at Timestamp.
+type PodContainersMetrics struct {
+	Namespace  string
+	Name       string
+	Timestamp  time.Time
+	Containers map[string]ResourceUsage
+}
+
 func GetPodMetrics(m *metricsapi.PodMetrics) v1.ResourceList {
 	podMetrics := make(v1.ResourceList)
 	for _, res := range metricsutil.MeasuredResources {
@@ -28,6 +37,15 @@ func GetPodMetrics(m *metricsapi.PodMetrics) v1.ResourceList {
 	return podMetrics
 }
 
+// GetContainersMetrics returns usages of containers by container name.
+func GetContainersMetrics(m *metricsapi.PodMetrics) map[string]v1.ResourceList {
+	containersMetrics := make(map[string]v1.ResourceList)
+	for _, c := range m.Containers {
+		containersMetrics[c.Name] = c.Usage
+	}
+	return containersMetrics
+}
+
 func GetAllResourceUsages(metrics *metricsutil.ResourceMetricsInfo) map[v1.ResourceName]int64 {
 	result := make(map[v1.ResourceName]int64)
 	for _, res := range metricsutil.MeasuredResources {
@@ -53,6 +71,14 @@ func GetSingleResourceUsage(resourceType v1.ResourceName, quantity resource.Quan
 	}
 }
 
+func (cli *KubeCLI) metricsClient() (*metricsclientset.Clientset, error) {
+	config, err := cli.factory.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	return metricsclientset.NewForConfig(config)
+}
+
 func (cli *KubeCLI) GetPodRawMetrics(
 	namespace, name string,
 	allNamespaces bool,
@@ -62,12 +88,7 @@ func (cli *KubeCLI) GetPodRawMetrics(
 		selector = Everything()
 	}
 
-	var err error
-	config, err := cli.factory.ToRESTConfig()
-	if err != nil {
-		return nil, err
-	}
-	metricsClient, err := metricsclientset.NewForConfig(config)
+	metricsClient, err := cli.metricsClient()
 	if err != nil {
 		return nil, err
 	}
@@ -116,3 +137,45 @@ func (cli *KubeCLI) GetPodMetrics(namespace, name string, allNamespaces bool, se
 	}
 	return result, nil
 }
+
+// GetPodContainersMetrics returns usages of containers of pod by container name.
+func (cli *KubeCLI) GetPodContainersMetrics(namespace, name string) (map[string]ResourceUsage, error) {
+	metrics, err := cli.GetPodRawMetrics(namespace, name, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]ResourceUsage)
+	for _, podMetrics := range newPodsContainersMetrics(metrics) {
+		for container, usage := range podMetrics.Containers {
+			result[container] = usage
+		}
+	}
+	return result, nil
+}
+
+// ListPodsContainersMetrics returns usages of containers of pods.
+func (cli *KubeCLI) ListPodsContainersMetrics(namespace string, allNamespaces bool, selector Selector) ([]*PodContainersMetrics, error) {
+	metrics, err := cli.GetPodRawMetrics(namespace, "", allNamespaces, selector)
+	if err != nil {
+		return nil, err
+	}
+	return newPodsContainersMetrics(metrics), nil
+}
+
+func newPodsContainersMetrics(metrics *metricsapi.PodMetricsList) []*PodContainersMetrics {
+	result := make([]*PodContainersMetrics, 0, len(metrics.Items))
+	for index, metric := range metrics.Items {
+		podMetrics := &PodContainersMetrics{
+			Namespace:  metric.Namespace,
+			Name:       metric.Name,
+			Timestamp:  metric.Timestamp.Time,
+			Containers: make(map[string]ResourceUsage),
+		}
+		for container, usage := range GetContainersMetrics(&metrics.Items[index]) {
+			podMetrics.Containers[container] = newResourceUsage(usage)
+		}
+		result = append(result, podMetrics)
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package kubecli
+
+import (
+	"fmt"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// GetUnstructured returns the latest resource by name in namespace of cli as unstructured object.
+func (cli *KubeCLI) GetUnstructured(resource, name string) (*unstructured.Unstructured, error) {
+	namespace, _, err := cli.factory.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := cli.factory.NewBuilder().
+		Unstructured().
+		NamespaceParam(namespace).DefaultNamespace().
+		ResourceTypeOrNameArgs(true, resource, name).
+		SingleResourceType().
+		Latest().
+		Do().
+		Infos()
+	if err != nil {
+		return nil, err
+	}
+	if len(infos) == 0 {
+		return nil, fmt.Errorf("%s '%s' not found", resource, name)
+	}
+
+	obj, ok := infos[0].Object.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("%s '%s' is not unstructured", resource, name)
+	}
+	return obj, nil
+}
+
+// ListUnstructured returns resources in namespace which matched the label selector.
+func (cli *KubeCLI) ListUnstructured(resource, labelSelector string) ([]*unstructured.Unstructured, error) {
+	namespace, _, err := cli.factory.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := cli.factory.NewBuilder().
+		Unstructured().
+		NamespaceParam(namespace).DefaultNamespace().
+		LabelSelectorParam(labelSelector).
+		ResourceTypeOrNameArgs(true, resource).
+		Flatten().
+		Latest().
+		Do().
+		Infos()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*unstructured.Unstructured, 0, len(infos))
+	for _, info := range infos {
+		if obj, ok := info.Object.(*unstructured.Unstructured); ok {
+			items = append(items, obj)
+		}
+	}
+	return items, nil
+}
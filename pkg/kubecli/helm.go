@@ -0,0 +1,352 @@
+package kubecli
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/resource"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	helmReleaseSecretType  = "helm.sh/release.v1"
+	helmOwnerLabelSelector = "owner=helm"
+	helmBinary             = "helm"
+	helmFieldManager       = "helm"
+
+	HelmStatusDeployed   = "deployed"
+	HelmStatusSuperseded = "superseded"
+)
+
+var (
+	gzipMagicHeader = []byte{0x1f, 0x8b, 0x08}
+)
+
+// HelmRelease a revision of helm release which stored in secret.
+type HelmRelease struct {
+	Name         string
+	Namespace    string
+	Revision     int
+	Status       string
+	Chart        string
+	ChartVersion string
+	AppVersion   string
+	Description  string
+	Notes        string
+	Manifest     string
+	Values       map[string]interface{}
+	Updated      time.Time
+
+	secretName string
+	raw        map[string]interface{}
+}
+
+// ListHelmReleases returns the latest revision of each helm release.
+func (cli *KubeCLI) ListHelmReleases(ctx context.Context, namespace string) ([]*HelmRelease, error) {
+	releases, err := cli.listHelmReleases(ctx, namespace, helmOwnerLabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[string]*HelmRelease)
+	for _, release := range releases {
+		key := release.Namespace + "/" + release.Name
+		if each, ok := latest[key]; !ok || each.Revision < release.Revision {
+			latest[key] = release
+		}
+	}
+
+	result := make([]*HelmRelease, 0, len(latest))
+	for _, release := range latest {
+		result = append(result, release)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// GetHelmReleaseHistory returns all revisions of helm release, the latest revision is the first.
+func (cli *KubeCLI) GetHelmReleaseHistory(ctx context.Context, namespace, name string) ([]*HelmRelease, error) {
+	releases, err := cli.listHelmReleases(ctx, namespace, fmt.Sprintf("%s,name=%s", helmOwnerLabelSelector, name))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Revision > releases[j].Revision
+	})
+	return releases, nil
+}
+
+// HelmInstalled check if helm binary can be found in PATH.
+func HelmInstalled() bool {
+	_, err := exec.LookPath(helmBinary)
+	return err == nil
+}
+
+// RollbackHelmRelease rollback helm release to revision by "helm rollback" if helm is installed,
+// otherwise the manifest of revision is applied and recorded as a new revision, hooks of chart will not be run in that case.
+func (cli *KubeCLI) RollbackHelmRelease(ctx context.Context, streams genericclioptions.IOStreams, namespace, name string, revision int) error {
+	if HelmInstalled() {
+		args := []string{"rollback", name, strconv.Itoa(revision), "--namespace", namespace}
+		if kubeContext := cli.contextName(); kubeContext != "" {
+			args = append(args, "--kube-context", kubeContext)
+		}
+		cmd := exec.CommandContext(ctx, helmBinary, args...)
+		cmd.Stdout, cmd.Stderr = streams.Out, streams.ErrOut
+		return cmd.Run()
+	}
+
+	history, err := cli.GetHelmReleaseHistory(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+	if len(history) == 0 {
+		return fmt.Errorf("helm release '%s' not found", name)
+	}
+
+	var target *HelmRelease
+	for _, release := range history {
+		if release.Revision == revision {
+			target = release
+			break
+		}
+	}
+	if target == nil {
+		return fmt.Errorf("revision %d of helm release '%s' not found", revision, name)
+	}
+	current := history[0]
+
+	// Release secrets should not be touched if the manifest was not applied.
+	// Note: Server side apply does not add "last-applied-configuration" annotation to objects which are managed by helm.
+	streams.In = strings.NewReader(target.Manifest)
+	if err := cli.WithNamespace(namespace).Apply(streams).
+		SetFlag("filename", "-").
+		SetFlag("server-side", "true").
+		SetFlag("force-conflicts", "true").
+		SetFlag("field-manager", helmFieldManager).
+		RunE(); err != nil {
+		return err
+	}
+	if err := cli.pruneHelmManifest(streams, namespace, current.Manifest, target.Manifest); err != nil {
+		return err
+	}
+
+	client, err := cli.ClientSet()
+	if err != nil {
+		return err
+	}
+	secrets := client.CoreV1().Secrets(namespace)
+
+	// Mark current revision as superseded.
+	currentSecret, err := secrets.Get(ctx, current.secretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	currentRaw := runtime.DeepCopyJSON(current.raw)
+	if err := unstructured.SetNestedField(currentRaw, HelmStatusSuperseded, "info", "status"); err != nil {
+		return err
+	}
+	data, err := encodeHelmRelease(currentRaw)
+	if err != nil {
+		return err
+	}
+	if currentSecret.Labels == nil {
+		currentSecret.Labels = map[string]string{}
+	}
+	currentSecret.Data["release"] = data
+	currentSecret.Labels["status"] = HelmStatusSuperseded
+	if _, err := secrets.Update(ctx, currentSecret, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	newRevision := current.Revision + 1
+	raw := runtime.DeepCopyJSON(target.raw)
+	raw["version"] = int64(newRevision)
+	if err := unstructured.SetNestedField(raw, HelmStatusDeployed, "info", "status"); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(raw, fmt.Sprintf("Rollback to %d", revision), "info", "description"); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(raw, time.Now().Format(time.RFC3339Nano), "info", "last_deployed"); err != nil {
+		return err
+	}
+	data, err = encodeHelmRelease(raw)
+	if err != nil {
+		return err
+	}
+
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("sh.helm.release.v1.%s.v%d", name, newRevision),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"name":    name,
+				"owner":   "helm",
+				"status":  HelmStatusDeployed,
+				"version": strconv.Itoa(newRevision),
+			},
+		},
+		Type: helmReleaseSecretType,
+		Data: map[string][]byte{"release": data},
+	}
+	if _, err := secrets.Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// pruneHelmManifest delete objects of current manifest which are absent from target manifest.
+func (cli *KubeCLI) pruneHelmManifest(streams genericclioptions.IOStreams, namespace, current, target string) error {
+	currentInfos, err := cli.manifestInfos(namespace, current)
+	if err != nil {
+		return err
+	}
+	targetInfos, err := cli.manifestInfos(namespace, target)
+	if err != nil {
+		return err
+	}
+
+	kept := make(map[string]bool)
+	for _, info := range targetInfos {
+		kept[manifestInfoKey(info)] = true
+	}
+	for _, info := range currentInfos {
+		if kept[manifestInfoKey(info)] {
+			continue
+		}
+		if _, err := resource.NewHelper(info.Client, info.Mapping).Delete(info.Namespace, info.Name); err != nil {
+			return err
+		}
+		fmt.Fprintf(streams.Out, "%s/%s pruned\n", info.Mapping.Resource.GroupResource(), info.Name)
+	}
+	return nil
+}
+
+func (cli *KubeCLI) manifestInfos(namespace, manifest string) ([]*resource.Info, error) {
+	return cli.factory.NewBuilder().
+		Unstructured().
+		NamespaceParam(namespace).DefaultNamespace().
+		Stream(strings.NewReader(manifest), "manifest").
+		Flatten().
+		Do().
+		Infos()
+}
+
+func manifestInfoKey(info *resource.Info) string {
+	return strings.Join([]string{info.Mapping.Resource.GroupResource().String(), info.Namespace, info.Name}, "/")
+}
+
+func (cli *KubeCLI) listHelmReleases(ctx context.Context, namespace, labelSelector string) ([]*HelmRelease, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := client.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, err
+	}
+
+	releases := make([]*HelmRelease, 0, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		if secret.Type != helmReleaseSecretType {
+			continue
+		}
+		raw, err := decodeHelmRelease(secret.Data["release"])
+		if err != nil {
+			continue
+		}
+		release := newHelmRelease(raw)
+		release.secretName = secret.Name
+		if release.Namespace == "" {
+			release.Namespace = secret.Namespace
+		}
+		releases = append(releases, release)
+	}
+	return releases, nil
+}
+
+func newHelmRelease(raw map[string]interface{}) *HelmRelease {
+	release := &HelmRelease{raw: raw}
+	release.Name, _, _ = unstructured.NestedString(raw, "name")
+	release.Namespace, _, _ = unstructured.NestedString(raw, "namespace")
+	revision, _, _ := unstructured.NestedInt64(raw, "version")
+	release.Revision = int(revision)
+	release.Status, _, _ = unstructured.NestedString(raw, "info", "status")
+	release.Description, _, _ = unstructured.NestedString(raw, "info", "description")
+	release.Notes, _, _ = unstructured.NestedString(raw, "info", "notes")
+	release.Manifest, _, _ = unstructured.NestedString(raw, "manifest")
+	release.Chart, _, _ = unstructured.NestedString(raw, "chart", "metadata", "name")
+	release.ChartVersion, _, _ = unstructured.NestedString(raw, "chart", "metadata", "version")
+	release.AppVersion, _, _ = unstructured.NestedString(raw, "chart", "metadata", "appVersion")
+	release.Values, _, _ = unstructured.NestedMap(raw, "config")
+
+	lastDeployed, _, _ := unstructured.NestedString(raw, "info", "last_deployed")
+	release.Updated, _ = time.Parse(time.RFC3339Nano, lastDeployed)
+	return release
+}
+
+// decodeHelmRelease decode release data which is base64 encoded gzipped json.
+func decodeHelmRelease(data []byte) (map[string]interface{}, error) {
+	decoded, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(decoded, gzipMagicHeader) {
+		reader, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		decoded, err = ioutil.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	raw := make(map[string]interface{})
+	if err := json.Unmarshal(decoded, &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+func encodeHelmRelease(raw map[string]interface{}) ([]byte, error) {
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	writer, err := gzip.NewWriterLevel(buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(encoded); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return []byte(base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+}
@@ -0,0 +1,195 @@
+package kubecli
+
+import (
+	"context"
+	"fmt"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	hpaKind = "HorizontalPodAutoscaler"
+)
+
+var (
+	// Note: autoscaling/v2 is not supported by old clusters and autoscaling/v2beta2 was removed by new clusters.
+	hpaResources = []string{
+		"horizontalpodautoscalers.v2.autoscaling",
+		"horizontalpodautoscalers.v2beta2.autoscaling",
+		"horizontalpodautoscalers.v1.autoscaling",
+	}
+)
+
+// HPAMetric current and target value of a metric of horizontal pod autoscaler.
+type HPAMetric struct {
+	Name    string
+	Current string
+	Target  string
+}
+
+// HPA horizontal pod autoscaler.
+type HPA struct {
+	Name            string
+	Namespace       string
+	MinReplicas     int64
+	MaxReplicas     int64
+	CurrentReplicas int64
+	DesiredReplicas int64
+	LastScaleTime   time.Time
+	Metrics         []*HPAMetric
+}
+
+// GetHPA returns the horizontal pod autoscaler which scale target is the resource, returns nil if not found.
+func (cli *KubeCLI) GetHPA(namespace, kind, name string) (*HPA, error) {
+	var items []*unstructured.Unstructured
+	var err error
+	for _, resource := range hpaResources {
+		items, err = cli.WithNamespace(namespace).ListUnstructured(resource, "")
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		targetKind, _, _ := unstructured.NestedString(item.Object, "spec", "scaleTargetRef", "kind")
+		targetName, _, _ := unstructured.NestedString(item.Object, "spec", "scaleTargetRef", "name")
+		if targetKind == kind && targetName == name {
+			return newHPA(item), nil
+		}
+	}
+	return nil, nil
+}
+
+// ListHPAEvents returns events of the horizontal pod autoscaler, the latest event is the first.
+func (cli *KubeCLI) ListHPAEvents(ctx context.Context, namespace, name string) ([]v1.Event, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	selector := fields.Set{
+		"involvedObject.kind": hpaKind,
+		"involvedObject.name": name,
+	}.AsSelector().String()
+	eventList, err := client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	events := eventList.Items
+	sort.Slice(events, func(i, j int) bool {
+		return EventTime(&events[j]).Before(EventTime(&events[i]))
+	})
+	return events, nil
+}
+
+// EventTime returns the last observed time of event.
+func EventTime(event *v1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+func newHPA(obj *unstructured.Unstructured) *HPA {
+	hpa := &HPA{
+		Name:        obj.GetName(),
+		Namespace:   obj.GetNamespace(),
+		MinReplicas: 1,
+	}
+	if minReplicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "minReplicas"); found {
+		hpa.MinReplicas = minReplicas
+	}
+	hpa.MaxReplicas, _, _ = unstructured.NestedInt64(obj.Object, "spec", "maxReplicas")
+	hpa.CurrentReplicas, _, _ = unstructured.NestedInt64(obj.Object, "status", "currentReplicas")
+	hpa.DesiredReplicas, _, _ = unstructured.NestedInt64(obj.Object, "status", "desiredReplicas")
+	if lastScaleTime, _, _ := unstructured.NestedString(obj.Object, "status", "lastScaleTime"); lastScaleTime != "" {
+		hpa.LastScaleTime, _ = time.Parse(time.RFC3339, lastScaleTime)
+	}
+
+	// autoscaling/v1
+	if target, found, _ := unstructured.NestedInt64(obj.Object, "spec", "targetCPUUtilizationPercentage"); found {
+		metric := &HPAMetric{Name: "resource cpu", Target: fmt.Sprintf("%d%%", target), Current: "<unknown>"}
+		if current, found, _ := unstructured.NestedInt64(obj.Object, "status", "currentCPUUtilizationPercentage"); found {
+			metric.Current = fmt.Sprintf("%d%%", current)
+		}
+		hpa.Metrics = append(hpa.Metrics, metric)
+		return hpa
+	}
+
+	// autoscaling/v2 and autoscaling/v2beta2
+	currentMetrics, _, _ := unstructured.NestedSlice(obj.Object, "status", "currentMetrics")
+	currents := make(map[string]string)
+	for _, each := range currentMetrics {
+		current, ok := each.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, source := hpaMetricSource(current)
+		currents[name] = hpaMetricValue(source, "current")
+	}
+
+	metrics, _, _ := unstructured.NestedSlice(obj.Object, "spec", "metrics")
+	for _, each := range metrics {
+		spec, ok := each.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, source := hpaMetricSource(spec)
+		current, ok := currents[name]
+		if !ok || current == "" {
+			current = "<unknown>"
+		}
+		hpa.Metrics = append(hpa.Metrics, &HPAMetric{
+			Name:    name,
+			Current: current,
+			Target:  hpaMetricValue(source, "target"),
+		})
+	}
+	return hpa
+}
+
+// hpaMetricSource returns name like "resource cpu" and the source of metric by metric type.
+func hpaMetricSource(metric map[string]interface{}) (string, map[string]interface{}) {
+	metricType, _, _ := unstructured.NestedString(metric, "type")
+	if metricType == "" {
+		return "", nil
+	}
+	sourceKey := strings.ToLower(metricType[:1]) + metricType[1:]
+
+	source, _, _ := unstructured.NestedMap(metric, sourceKey)
+	name, found, _ := unstructured.NestedString(source, "name")
+	if !found {
+		name, _, _ = unstructured.NestedString(source, "metric", "name")
+	}
+	if container, found, _ := unstructured.NestedString(source, "container"); found {
+		name = fmt.Sprintf("%s/%s", container, name)
+	}
+	return fmt.Sprintf("%s %s", strings.ToLower(metricType), name), source
+}
+
+// hpaMetricValue format value of "current" or "target" of metric source.
+func hpaMetricValue(source map[string]interface{}, field string) string {
+	values := make([]string, 0)
+	if utilization, found, _ := unstructured.NestedInt64(source, field, "averageUtilization"); found {
+		values = append(values, fmt.Sprintf("%d%%", utilization))
+	}
+	if averageValue, found, _ := unstructured.NestedString(source, field, "averageValue"); found {
+		values = append(values, fmt.Sprintf("%s (avg)", averageValue))
+	}
+	if value, found, _ := unstructured.NestedString(source, field, "value"); found {
+		values = append(values, value)
+	}
+	return strings.Join(values, " / ")
+}
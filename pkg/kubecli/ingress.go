@@ -0,0 +1,112 @@
+package kubecli
+
+import (
+	"context"
+	"fmt"
+	"github.com/TNK-Studio/lazykube/pkg/kubecli/clusterinfo"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IngressBackend a backend service which a host and path of ingress routed to.
+type IngressBackend struct {
+	Host           string
+	Path           string
+	Service        string
+	Port           string
+	ReadyEndpoints int
+}
+
+// GetIngressURLs returns urls of the ingress.
+func (cli *KubeCLI) GetIngressURLs(ctx context.Context, namespace, name string) ([]string, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	ingress, err := client.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return clusterinfo.URLsOfIngress(ingress), nil
+}
+
+// GetIngressBackends returns backends of the ingress with the count of ready endpoints.
+func (cli *KubeCLI) GetIngressBackends(ctx context.Context, namespace, name string) ([]*IngressBackend, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	ingress, err := client.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	backends := make([]*IngressBackend, 0)
+	if ingress.Spec.DefaultBackend != nil {
+		backends = append(backends, newIngressBackend("*", "(default)", ingress.Spec.DefaultBackend))
+	}
+	for _, rule := range ingress.Spec.Rules {
+		host := rule.Host
+		if host == "" {
+			host = "*"
+		}
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			backend := path.Backend
+			backends = append(backends, newIngressBackend(host, path.Path, &backend))
+		}
+	}
+
+	readyEndpoints := make(map[string]int)
+	for _, backend := range backends {
+		// Resource backend has no port.
+		if backend.Service == "" || backend.Port == "" {
+			continue
+		}
+		if _, ok := readyEndpoints[backend.Service]; ok {
+			backend.ReadyEndpoints = readyEndpoints[backend.Service]
+			continue
+		}
+
+		endpoints, err := client.CoreV1().Endpoints(namespace).Get(ctx, backend.Service, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		backend.ReadyEndpoints = countReadyEndpoints(endpoints)
+		readyEndpoints[backend.Service] = backend.ReadyEndpoints
+	}
+	return backends, nil
+}
+
+func newIngressBackend(host, path string, backend *networkingv1.IngressBackend) *IngressBackend {
+	ingressBackend := &IngressBackend{Host: host, Path: path}
+	if backend.Service != nil {
+		ingressBackend.Service = backend.Service.Name
+		ingressBackend.Port = backend.Service.Port.Name
+		if ingressBackend.Port == "" {
+			ingressBackend.Port = fmt.Sprint(backend.Service.Port.Number)
+		}
+	}
+	if backend.Resource != nil {
+		ingressBackend.Service = fmt.Sprintf("%s/%s", backend.Resource.Kind, backend.Resource.Name)
+	}
+	return ingressBackend
+}
+
+func countReadyEndpoints(endpoints *v1.Endpoints) int {
+	if endpoints == nil {
+		return 0
+	}
+
+	count := 0
+	for _, subset := range endpoints.Subsets {
+		count += len(subset.Addresses)
+	}
+	return count
+}
@@ -1,6 +1,7 @@
 package kubecli
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"github.com/TNK-Studio/lazykube/pkg/kubecli/clusterinfo"
@@ -10,6 +11,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"io/ioutil"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/azure"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/exec"
@@ -18,9 +20,16 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth/openstack"
 	"k8s.io/klog/v2"
 	"k8s.io/kubectl/pkg/cmd/util"
+	"strings"
+	"sync"
 )
 
-var Cli *KubeCLI
+var (
+	Cli *KubeCLI
+
+	contextClis   = make(map[string]*KubeCLI)
+	contextClisMu sync.Mutex
+)
 
 func init() {
 	Cli = NewKubeCLI()
@@ -29,9 +38,10 @@ func init() {
 }
 
 type KubeCLI struct {
-	factory      util.Factory
-	namespace    *string
-	context      *string
+	factory           util.Factory
+	namespace         *string
+	context           *string
+	previousNamespace string
 }
 
 type Cmd struct {
@@ -49,10 +59,20 @@ func NewCmd(cmd *cobra.Command, args []string, streams genericclioptions.IOStrea
 }
 
 func (c *Cmd) Run() {
+	_ = c.RunE()
+}
+
+// RunE run command like Run, but returns the first fatal error of command.
+func (c *Cmd) RunE() error {
+	var fatalErr error
 	util.BehaviorOnFatal(func(s string, i int) {
 		_, _ = fmt.Fprint(c.streams.ErrOut, s)
+		if fatalErr == nil {
+			fatalErr = errors.New(strings.TrimSpace(s))
+		}
 	})
 	c.cmd.Run(c.cmd, c.args)
+	return fatalErr
 }
 
 func (c *Cmd) SetFlag(name, value string) *Cmd {
@@ -79,7 +99,39 @@ func NewKubeCLI() *KubeCLI {
 	return k
 }
 
+// NewKubeCLIWithContext create KubeCLI of context, it does not change the current context.
+func NewKubeCLIWithContext(context, namespace string) *KubeCLI {
+	kubeConfigFlags := genericclioptions.NewConfigFlags(true).WithDeprecatedPasswordFlag()
+	kubeConfigFlags.Namespace = &namespace
+	kubeConfigFlags.Context = &context
+
+	matchVersionKubeConfigFlags := util.NewMatchVersionFlags(kubeConfigFlags)
+
+	return &KubeCLI{
+		factory:   util.NewFactory(matchVersionKubeConfigFlags),
+		namespace: &namespace,
+		context:   &context,
+	}
+}
+
+// ContextCli returns KubeCLI of context, they are kept alive alongside Cli.
+// It is safe to be used in background since it is never changed, unlike Cli which is changed when switching context or namespace.
+func ContextCli(context string) *KubeCLI {
+	contextClisMu.Lock()
+	defer contextClisMu.Unlock()
+	if cli, ok := contextClis[context]; ok {
+		return cli
+	}
+	cli := NewKubeCLIWithContext(context, "")
+	contextClis[context] = cli
+	return cli
+}
+
 func (cli *KubeCLI) SetNamespace(namespace string) {
+	if cli.namespace != nil && *cli.namespace != "" {
+		cli.previousNamespace = *cli.namespace
+	}
+
 	kubeConfigFlags := genericclioptions.NewConfigFlags(true).WithDeprecatedPasswordFlag()
 	kubeConfigFlags.Namespace = &namespace
 	kubeConfigFlags.Context = cli.context
@@ -110,6 +162,7 @@ func (cli *KubeCLI) WithNamespace(namespace string) *KubeCLI {
 	k := &KubeCLI{
 		factory:   util.NewFactory(matchVersionKubeConfigFlags),
 		namespace: &namespace,
+		context:   cli.context,
 	}
 	return k
 }
@@ -118,14 +171,55 @@ func (cli *KubeCLI) Namespace() string {
 	return *cli.namespace
 }
 
+// AllNamespaces returns true when resources are listed across all namespaces.
+func (cli *KubeCLI) AllNamespaces() bool {
+	return cli.Namespace() == ""
+}
+
+// PreviousNamespace returns the last namespace before switching, it is used to leave all namespaces mode.
+func (cli *KubeCLI) PreviousNamespace() string {
+	if cli.previousNamespace == "" {
+		return v1.NamespaceDefault
+	}
+	return cli.previousNamespace
+}
+
 func (cli *KubeCLI) CurrentContext() string {
 	return config.CurrentContext()
 }
 
+// contextName returns the context which cli was created with, empty means the current context of kubeconfig.
+func (cli *KubeCLI) contextName() string {
+	if cli.context == nil {
+		return ""
+	}
+	return *cli.context
+}
+
 func (cli *KubeCLI) ListContexts() []string {
 	return config.ListContexts()
 }
 
+// ListContextDetails returns sorted contexts with cluster, user and namespace.
+func (cli *KubeCLI) ListContextDetails() []*config.Context {
+	contexts := make([]*config.Context, 0)
+	for _, name := range config.ListContexts() {
+		if ctx, ok := config.GetContext(name); ok {
+			contexts = append(contexts, ctx)
+		}
+	}
+	return contexts
+}
+
+// ContextNamespace returns namespace of current context in kubeconfig.
+func (cli *KubeCLI) ContextNamespace() string {
+	return config.ContextNamespace()
+}
+
+func (cli *KubeCLI) PersistCurrentContext() error {
+	return config.PersistCurrentContext()
+}
+
 func (cli *KubeCLI) ClusterInfo() (string, error) {
 	return clusterinfo.ClusterInfo(cli.factory)
 }
@@ -0,0 +1,204 @@
+package kubecli
+
+import (
+	"errors"
+	"fmt"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/json"
+	"strings"
+)
+
+// Prefixes of fieldsV1 keys, see https://kubernetes.io/docs/reference/using-api/server-side-apply/#field-management
+const (
+	fieldsSetFieldPrefix = "f:"
+	fieldsSetKeyPrefix   = "k:"
+	fieldsSetValuePrefix = "v:"
+	fieldsSetIndexPrefix = "i:"
+	fieldsSetSelf        = "."
+)
+
+var (
+	// LastAppliedNotFoundErr LastAppliedNotFoundErr
+	LastAppliedNotFoundErr = errors.New("Resource has no last-applied-configuration annotation. ")
+
+	// Fields only set by these managers are not drifts made by users, such as revision annotation of deployments.
+	ignoredFieldManagers = map[string]bool{
+		"kube-controller-manager": true,
+		"kube-scheduler":          true,
+		"kubelet":                 true,
+	}
+)
+
+// GetLastAppliedDrift returns YAML of the last-applied configuration and the live object of resource.
+// The live object keeps the fields which existed in the last-applied configuration or were set by a manager,
+// so status, managedFields and defaulted fields will be ignored while fields added by "kubectl edit" remain.
+func (cli *KubeCLI) GetLastAppliedDrift(resource, name string) (string, string, error) {
+	obj, err := cli.GetUnstructured(resource, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	lastAppliedJSON, ok := obj.GetAnnotations()[v1.LastAppliedConfigAnnotation]
+	if !ok || lastAppliedJSON == "" {
+		return "", "", LastAppliedNotFoundErr
+	}
+
+	lastApplied := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(lastAppliedJSON), &lastApplied); err != nil {
+		return "", "", err
+	}
+	delete(lastApplied, "status")
+
+	managed, err := managedFieldsSet(obj.GetManagedFields())
+	if err != nil {
+		return "", "", err
+	}
+
+	content := obj.UnstructuredContent()
+	delete(content, "status")
+	unstructured.RemoveNestedField(content, "metadata", "managedFields")
+	unstructured.RemoveNestedField(content, "metadata", "annotations", v1.LastAppliedConfigAnnotation)
+	live := pruneLive(content, lastApplied, managed)
+
+	lastAppliedYAML, err := ToYAML(lastApplied)
+	if err != nil {
+		return "", "", err
+	}
+	liveYAML, err := ToYAML(live)
+	if err != nil {
+		return "", "", err
+	}
+	return lastAppliedYAML, liveYAML, nil
+}
+
+// managedFieldsSet merges fieldsV1 of managers, fields only managed by control plane components are excluded.
+// It returns nil if there are no managed fields.
+func managedFieldsSet(entries []metav1.ManagedFieldsEntry) (map[string]interface{}, error) {
+	var managed map[string]interface{}
+	for _, entry := range entries {
+		if entry.FieldsV1 == nil || ignoredFieldManagers[entry.Manager] {
+			continue
+		}
+		fields := make(map[string]interface{})
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			return nil, err
+		}
+		if managed == nil {
+			managed = make(map[string]interface{})
+		}
+		mergeFieldsSet(managed, fields)
+	}
+	return managed, nil
+}
+
+func mergeFieldsSet(dst, src map[string]interface{}) {
+	for key, val := range src {
+		srcMap, _ := val.(map[string]interface{})
+		dstMap, ok := dst[key].(map[string]interface{})
+		if !ok {
+			dstMap = make(map[string]interface{})
+			dst[key] = dstMap
+		}
+		mergeFieldsSet(dstMap, srcMap)
+	}
+}
+
+// pruneLive drop fields of live which neither existed in template nor were set by a manager.
+// A nil managed means there is no managed field information, only fields of template will be kept for maps then.
+func pruneLive(live, template interface{}, managed map[string]interface{}) interface{} {
+	switch liveVal := live.(type) {
+	case map[string]interface{}:
+		tmpl, ok := template.(map[string]interface{})
+		if template != nil && !ok {
+			return live
+		}
+		// The whole map is managed as a leaf, or it is an extra list element without managed field information.
+		if template == nil && len(managed) == 0 {
+			return live
+		}
+		pruned := make(map[string]interface{})
+		for key, val := range liveVal {
+			tmplVal, inTemplate := tmpl[key]
+			managedVal, inManaged := managed[fieldsSetFieldPrefix+key]
+			if !inTemplate && !inManaged {
+				continue
+			}
+			managedMap, _ := managedVal.(map[string]interface{})
+			if inManaged && managedMap == nil {
+				managedMap = make(map[string]interface{})
+			}
+			pruned[key] = pruneLive(val, tmplVal, managedMap)
+		}
+		return pruned
+	case []interface{}:
+		tmpl, ok := template.([]interface{})
+		if template != nil && !ok {
+			return live
+		}
+		if template == nil && len(managed) == 0 {
+			return live
+		}
+		pruned := make([]interface{}, 0, len(liveVal))
+		for index, val := range liveVal {
+			var tmplVal interface{}
+			inTemplate := index < len(tmpl)
+			if inTemplate {
+				tmplVal = tmpl[index]
+			}
+			managedMap, inManaged := managedListElement(managed, index, val)
+			// Keep extra elements if there is no managed field information.
+			if !inTemplate && !inManaged && managed != nil {
+				continue
+			}
+			pruned = append(pruned, pruneLive(val, tmplVal, managedMap))
+		}
+		return pruned
+	}
+	return live
+}
+
+// managedListElement finds the fields set of list element by index, key fields or value.
+func managedListElement(managed map[string]interface{}, index int, element interface{}) (map[string]interface{}, bool) {
+	for key, val := range managed {
+		matched := false
+		switch {
+		case strings.HasPrefix(key, fieldsSetIndexPrefix):
+			matched = key == fmt.Sprintf("%s%d", fieldsSetIndexPrefix, index)
+		case strings.HasPrefix(key, fieldsSetKeyPrefix):
+			keyFields := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(key, fieldsSetKeyPrefix)), &keyFields); err != nil {
+				continue
+			}
+			elementMap, ok := element.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			matched = true
+			for field, fieldVal := range keyFields {
+				if fmt.Sprint(elementMap[field]) != fmt.Sprint(fieldVal) {
+					matched = false
+					break
+				}
+			}
+		case strings.HasPrefix(key, fieldsSetValuePrefix):
+			var value interface{}
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(key, fieldsSetValuePrefix)), &value); err != nil {
+				continue
+			}
+			matched = fmt.Sprint(value) == fmt.Sprint(element)
+		}
+		if !matched {
+			continue
+		}
+		managedMap, _ := val.(map[string]interface{})
+		if managedMap == nil {
+			managedMap = make(map[string]interface{})
+		}
+		// "." means the element itself is managed.
+		delete(managedMap, fieldsSetSelf)
+		return managedMap, true
+	}
+	return nil, false
+}
@@ -0,0 +1,134 @@
+package kubecli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPruneLive(t *testing.T) {
+	template := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "app"},
+		"spec": map[string]interface{}{
+			"replicas": int64(1),
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "app:v1"},
+			},
+		},
+	}
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":        "app",
+			"annotations": map[string]interface{}{"hotfix": "true"},
+		},
+		"spec": map[string]interface{}{
+			"replicas":      int64(3),
+			"dnsPolicy":     "ClusterFirst",
+			"schedulerName": "default-scheduler",
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":                   "app",
+					"image":                  "app:v2",
+					"terminationMessagePath": "/dev/termination-log",
+					"env":                    []interface{}{map[string]interface{}{"name": "DEBUG", "value": "1"}},
+				},
+				map[string]interface{}{"name": "sidecar", "image": "sidecar:v1"},
+			},
+		},
+	}
+	managed := map[string]interface{}{
+		"f:metadata": map[string]interface{}{
+			"f:annotations": map[string]interface{}{"f:hotfix": map[string]interface{}{}},
+		},
+		"f:spec": map[string]interface{}{
+			"f:replicas": map[string]interface{}{},
+			"f:containers": map[string]interface{}{
+				`k:{"name":"app"}`: map[string]interface{}{
+					".":     map[string]interface{}{},
+					"f:env": map[string]interface{}{},
+				},
+				`k:{"name":"sidecar"}`: map[string]interface{}{
+					".":       map[string]interface{}{},
+					"f:name":  map[string]interface{}{},
+					"f:image": map[string]interface{}{},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		managed map[string]interface{}
+		want    interface{}
+	}{
+		{
+			name:    "keep managed fields",
+			managed: managed,
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":        "app",
+					"annotations": map[string]interface{}{"hotfix": "true"},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(3),
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":  "app",
+							"image": "app:v2",
+							"env":   []interface{}{map[string]interface{}{"name": "DEBUG", "value": "1"}},
+						},
+						map[string]interface{}{"name": "sidecar", "image": "sidecar:v1"},
+					},
+				},
+			},
+		},
+		{
+			name:    "no managed fields",
+			managed: nil,
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "app"},
+				"spec": map[string]interface{}{
+					"replicas": int64(3),
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "image": "app:v2"},
+						map[string]interface{}{"name": "sidecar", "image": "sidecar:v1"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pruneLive(live, template, tt.managed); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pruneLive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManagedListElement(t *testing.T) {
+	managed := map[string]interface{}{
+		`k:{"containerPort":80,"protocol":"TCP"}`: map[string]interface{}{".": map[string]interface{}{}},
+		`v:"finalizer"`: map[string]interface{}{},
+		"i:2":           map[string]interface{}{"f:a": map[string]interface{}{}},
+	}
+
+	tests := []struct {
+		name    string
+		index   int
+		element interface{}
+		want    bool
+	}{
+		{"key fields", 0, map[string]interface{}{"containerPort": int64(80), "protocol": "TCP"}, true},
+		{"key fields mismatch", 0, map[string]interface{}{"containerPort": int64(81), "protocol": "TCP"}, false},
+		{"value", 0, "finalizer", true},
+		{"index", 2, map[string]interface{}{"a": "b"}, true},
+		{"not managed", 1, "other", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := managedListElement(managed, tt.index, tt.element); got != tt.want {
+				t.Errorf("managedListElement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package kubecli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const mergedLogsParallel = 8
+
+// FollowLogsTimeout timeout of fetching logs of a container when following logs, full logs should be fetched without timeout.
+const FollowLogsTimeout = 10 * time.Second
+
+// LogLine a line of container logs with timestamp.
+type LogLine struct {
+	Time      time.Time
+	Pod       string
+	Container string
+	Message   string
+}
+
+// ListPodsBySelector list pods which matched label selector.
+func (cli *KubeCLI) ListPodsBySelector(namespace, selector string) ([]v1.Pod, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := client.CoreV1().Pods(namespace).List(context.Background(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// ContainerLogsError error of fetching logs of a container.
+type ContainerLogsError struct {
+	Pod       string
+	Container string
+	Err       error
+}
+
+func (err *ContainerLogsError) Error() string {
+	return fmt.Sprintf("[%s/%s] %s", err.Pod, err.Container, err.Err)
+}
+
+// MergedLogs fetch logs of all init containers and containers of pods with timestamps, lines will be merged in time order.
+// Logs since the time returned by sinceTime will be fetched if it is not zero, otherwise the last tail lines of the container, negative tail means all lines.
+// Errors of containers are returned separately, so that logs of the other containers can still be shown.
+// Zero timeout means fetching logs of each container without timeout.
+func (cli *KubeCLI) MergedLogs(namespace string, pods []v1.Pod, sinceTime func(pod, container string) time.Time, tail int64, timeout time.Duration) ([]*LogLine, []*ContainerLogsError, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		lines  = make([]*LogLine, 0)
+		errs   = make([]*ContainerLogsError, 0)
+		tokens = make(chan struct{}, mergedLogsParallel)
+	)
+	for _, pod := range pods {
+		containers := make([]v1.Container, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+		containers = append(containers, pod.Spec.InitContainers...)
+		containers = append(containers, pod.Spec.Containers...)
+		for _, container := range containers {
+			opts := &v1.PodLogOptions{Container: container.Name, Timestamps: true}
+			if since := sinceTime(pod.Name, container.Name); since.IsZero() {
+				if tail >= 0 {
+					opts.TailLines = &tail
+				}
+			} else {
+				since := metav1.NewTime(since)
+				opts.SinceTime = &since
+			}
+
+			wg.Add(1)
+			go func(podName, containerName string, opts *v1.PodLogOptions) {
+				defer wg.Done()
+				tokens <- struct{}{}
+				defer func() { <-tokens }()
+
+				ctx := context.Background()
+				if timeout > 0 {
+					var cancel context.CancelFunc
+					ctx, cancel = context.WithTimeout(ctx, timeout)
+					defer cancel()
+				}
+				raw, err := client.CoreV1().Pods(namespace).GetLogs(podName, opts).DoRaw(ctx)
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					errs = append(errs, &ContainerLogsError{Pod: podName, Container: containerName, Err: err})
+					return
+				}
+				lines = append(lines, parseTimestampedLogs(raw, podName, containerName)...)
+			}(pod.Name, container.Name, opts)
+		}
+	}
+	wg.Wait()
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].Time.Before(lines[j].Time)
+	})
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Error() < errs[j].Error()
+	})
+	return lines, errs, nil
+}
+
+func parseTimestampedLogs(raw []byte, pod, container string) []*LogLine {
+	lines := make([]*LogLine, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+		parts := strings.SplitN(text, " ", 2)
+		timestamp, err := time.Parse(time.RFC3339Nano, parts[0])
+		if err != nil {
+			// Line without timestamp, keep it after the previous line.
+			if len(lines) > 0 {
+				timestamp = lines[len(lines)-1].Time
+			}
+			lines = append(lines, &LogLine{Time: timestamp, Pod: pod, Container: container, Message: text})
+			continue
+		}
+
+		message := ""
+		if len(parts) > 1 {
+			message = parts[1]
+		}
+		lines = append(lines, &LogLine{Time: timestamp, Pod: pod, Container: container, Message: message})
+	}
+	return lines
+}
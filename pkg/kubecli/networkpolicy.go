@@ -0,0 +1,57 @@
+package kubecli
+
+import (
+	"context"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// GetPodNetworkPolicies returns the pod and network policies which select the pod.
+func (cli *KubeCLI) GetPodNetworkPolicies(ctx context.Context, namespace, name string) (*v1.Pod, []networkingv1.NetworkPolicy, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pod, err := client.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policyList, err := client.NetworkingV1().NetworkPolicies(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policies := make([]networkingv1.NetworkPolicy, 0)
+	for _, policy := range policyList.Items {
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(pod.Labels)) {
+			policies = append(policies, policy)
+		}
+	}
+	return pod, policies, nil
+}
+
+// NetworkPolicyTypes returns effective policy types of network policy.
+// Note: Ingress is always set and Egress is set if there are any egress rules when policy types are not specified.
+func NetworkPolicyTypes(policy *networkingv1.NetworkPolicy) (ingress bool, egress bool) {
+	if len(policy.Spec.PolicyTypes) == 0 {
+		return true, len(policy.Spec.Egress) > 0
+	}
+
+	for _, policyType := range policy.Spec.PolicyTypes {
+		switch policyType {
+		case networkingv1.PolicyTypeIngress:
+			ingress = true
+		case networkingv1.PolicyTypeEgress:
+			egress = true
+		}
+	}
+	return ingress, egress
+}
@@ -0,0 +1,12 @@
+package kubecli
+
+import (
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/kubectl/pkg/cmd/patch"
+)
+
+// Patch Patch
+func (cli *KubeCLI) Patch(streams genericclioptions.IOStreams, args ...string) *Cmd {
+	cmd := patch.NewCmdPatch(cli.factory, streams)
+	return NewCmd(cmd, args, streams)
+}
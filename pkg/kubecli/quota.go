@@ -0,0 +1,35 @@
+package kubecli
+
+import (
+	"context"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListResourceQuotas ListResourceQuotas
+func (cli *KubeCLI) ListResourceQuotas(ctx context.Context, namespace string) ([]v1.ResourceQuota, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	quotaList, err := client.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return quotaList.Items, nil
+}
+
+// ListLimitRanges ListLimitRanges
+func (cli *KubeCLI) ListLimitRanges(ctx context.Context, namespace string) ([]v1.LimitRange, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	limitRangeList, err := client.CoreV1().LimitRanges(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return limitRangeList.Items, nil
+}
@@ -0,0 +1,155 @@
+package kubecli
+
+import (
+	"context"
+	"fmt"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	accessReviewCacheDuration = 1 * time.Minute
+)
+
+var (
+	accessReviewCache = &sync.Map{}
+)
+
+type accessReviewResult struct {
+	allowed bool
+	expire  time.Time
+}
+
+// ResourceRule verbs which current user can perform on the resource.
+type ResourceRule struct {
+	Resource      string
+	ResourceNames []string
+	Verbs         []string
+}
+
+// CanI checks whether current user can perform the verb on the resource by SelfSubjectAccessReview like "kubectl auth can-i".
+func (cli *KubeCLI) CanI(ctx context.Context, namespace, verb, resource, subresource string) (bool, error) {
+	gvr := cli.getResourceGroupVersionResource(resource)
+	key := strings.Join([]string{cli.contextName(), namespace, verb, gvr.Group, gvr.Resource, subresource}, "/")
+	if val, ok := accessReviewCache.Load(key); ok {
+		result := val.(*accessReviewResult)
+		if time.Now().Before(result.expire) {
+			return result.allowed, nil
+		}
+	}
+
+	client, err := cli.ClientSet()
+	if err != nil {
+		return false, err
+	}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   namespace,
+				Verb:        verb,
+				Group:       gvr.Group,
+				Resource:    gvr.Resource,
+				Subresource: subresource,
+			},
+		},
+	}
+	review, err = client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	accessReviewCache.Store(key, &accessReviewResult{
+		allowed: review.Status.Allowed,
+		expire:  time.Now().Add(accessReviewCacheDuration),
+	})
+	return review.Status.Allowed, nil
+}
+
+// ListResourceRules returns resource rules of current user in namespace by SelfSubjectRulesReview like "kubectl auth can-i --list".
+// The rules may be incomplete which depends on authorizer of cluster.
+func (cli *KubeCLI) ListResourceRules(ctx context.Context, namespace string) ([]*ResourceRule, bool, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, false, err
+	}
+
+	review := &authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: namespace},
+	}
+	review, err = client.AuthorizationV1().SelfSubjectRulesReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+
+	rules := make(map[string]*ResourceRule)
+	for _, rule := range review.Status.ResourceRules {
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				name := resource
+				if group != "" {
+					name = fmt.Sprintf("%s.%s", resource, group)
+				}
+				key := name + strings.Join(rule.ResourceNames, ",")
+				if _, ok := rules[key]; !ok {
+					rules[key] = &ResourceRule{Resource: name, ResourceNames: rule.ResourceNames}
+				}
+				rules[key].Verbs = appendMissing(rules[key].Verbs, rule.Verbs...)
+			}
+		}
+	}
+
+	result := make([]*ResourceRule, 0, len(rules))
+	for _, rule := range rules {
+		sort.Strings(rule.Verbs)
+		result = append(result, rule)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Resource != result[j].Resource {
+			return result[i].Resource < result[j].Resource
+		}
+		return strings.Join(result[i].ResourceNames, ",") < strings.Join(result[j].ResourceNames, ",")
+	})
+	return result, review.Status.Incomplete, nil
+}
+
+func (cli *KubeCLI) getResourceGroupVersionResource(resourceArg string) schema.GroupVersionResource {
+	fullySpecifiedGVR, groupResource := schema.ParseResourceArg(resourceArg)
+	gvr := groupResource.WithVersion("")
+
+	restMapper, err := cli.factory.ToRESTMapper()
+	if err != nil {
+		return gvr
+	}
+
+	if fullySpecifiedGVR != nil {
+		if mapped, err := restMapper.ResourceFor(*fullySpecifiedGVR); err == nil {
+			return mapped
+		}
+	}
+	if mapped, err := restMapper.ResourceFor(groupResource.WithVersion("")); err == nil {
+		return mapped
+	}
+	return gvr
+}
+
+func appendMissing(slice []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, each := range slice {
+			if each == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			slice = append(slice, value)
+		}
+	}
+	return slice
+}
@@ -0,0 +1,104 @@
+package kubecli
+
+import (
+	"context"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sort"
+	"strings"
+)
+
+var (
+	accessModeShortNames = map[v1.PersistentVolumeAccessMode]string{
+		v1.ReadWriteOnce:   "RWO",
+		v1.ReadOnlyMany:    "ROX",
+		v1.ReadWriteMany:   "RWX",
+		"ReadWriteOncePod": "RWOP",
+	}
+)
+
+// PersistentVolumeClaim persistent volume claim with pods which mounting it.
+type PersistentVolumeClaim struct {
+	v1.PersistentVolumeClaim
+	MountedBy []string
+}
+
+// ListPersistentVolumeClaims returns persistent volume claims with pods which mounting them.
+func (cli *KubeCLI) ListPersistentVolumeClaims(ctx context.Context, namespace string) ([]*PersistentVolumeClaim, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	claimList, err := client.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	podList, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	mountedBy := make(map[string][]string)
+	for _, pod := range podList.Items {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil {
+				continue
+			}
+			key := pod.Namespace + "/" + volume.PersistentVolumeClaim.ClaimName
+			mountedBy[key] = append(mountedBy[key], pod.Name)
+		}
+	}
+
+	claims := make([]*PersistentVolumeClaim, 0, len(claimList.Items))
+	for _, claim := range claimList.Items {
+		pods := mountedBy[claim.Namespace+"/"+claim.Name]
+		sort.Strings(pods)
+		claims = append(claims, &PersistentVolumeClaim{PersistentVolumeClaim: claim, MountedBy: pods})
+	}
+	sort.Slice(claims, func(i, j int) bool {
+		if claims[i].Namespace != claims[j].Namespace {
+			return claims[i].Namespace < claims[j].Namespace
+		}
+		return claims[i].Name < claims[j].Name
+	})
+	return claims, nil
+}
+
+// Capacity returns the actual capacity of bound claim, or the requested storage.
+func (claim *PersistentVolumeClaim) Capacity() string {
+	if storage, ok := claim.Status.Capacity[v1.ResourceStorage]; ok {
+		return storage.String()
+	}
+	if storage, ok := claim.Spec.Resources.Requests[v1.ResourceStorage]; ok {
+		return storage.String()
+	}
+	return ""
+}
+
+// AccessModes returns access modes like "RWO,ROX".
+func (claim *PersistentVolumeClaim) AccessModes() string {
+	accessModes := claim.Status.AccessModes
+	if len(accessModes) == 0 {
+		accessModes = claim.Spec.AccessModes
+	}
+
+	modes := make([]string, 0, len(accessModes))
+	for _, mode := range accessModes {
+		if shortName, ok := accessModeShortNames[mode]; ok {
+			modes = append(modes, shortName)
+			continue
+		}
+		modes = append(modes, string(mode))
+	}
+	return strings.Join(modes, ",")
+}
+
+// StorageClass returns storage class name of claim.
+func (claim *PersistentVolumeClaim) StorageClass() string {
+	if claim.Spec.StorageClassName != nil {
+		return *claim.Spec.StorageClassName
+	}
+	return claim.Annotations[v1.BetaStorageClassAnnotation]
+}
@@ -0,0 +1,149 @@
+package kubecli
+
+import (
+	"context"
+	"fmt"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	. "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubectl/pkg/metricsutil"
+	resourcehelper "k8s.io/kubectl/pkg/util/resource"
+)
+
+const runningPodsFieldSelector = "status.phase!=Succeeded,status.phase!=Failed"
+
+// ResourceUsage cpu usage in millicores and memory usage in Mi.
+type ResourceUsage map[v1.ResourceName]int64
+
+// Add add usage of other.
+func (usage ResourceUsage) Add(other ResourceUsage) {
+	for res, value := range other {
+		usage[res] += value
+	}
+}
+
+// RequestsAndLimits sum of resource requests and limits.
+type RequestsAndLimits struct {
+	Requests ResourceUsage
+	Limits   ResourceUsage
+}
+
+func newResourceUsage(list v1.ResourceList) ResourceUsage {
+	usage := make(ResourceUsage)
+	for _, res := range metricsutil.MeasuredResources {
+		quantity, ok := list[res]
+		if !ok {
+			continue
+		}
+		usage[res] = GetSingleResourceUsage(res, quantity)
+	}
+	return usage
+}
+
+// GetNodesMetrics returns usages of nodes by node name.
+func (cli *KubeCLI) GetNodesMetrics() (map[string]ResourceUsage, error) {
+	metricsClient, err := cli.metricsClient()
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := metricsClient.MetricsV1beta1().NodeMetricses().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]ResourceUsage)
+	for _, metric := range metrics.Items {
+		result[metric.Name] = newResourceUsage(metric.Usage)
+	}
+	return result, nil
+}
+
+// GetNodesAllocatable returns the sum of allocatable resources of all nodes.
+func (cli *KubeCLI) GetNodesAllocatable() (ResourceUsage, error) {
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := client.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(ResourceUsage)
+	for _, node := range nodes.Items {
+		result.Add(newResourceUsage(node.Status.Allocatable))
+	}
+	return result, nil
+}
+
+// GetPodsMetrics returns usages of pods by pod name, name will be prefixed with namespace if allNamespaces.
+func (cli *KubeCLI) GetPodsMetrics(namespace string, allNamespaces bool, selector Selector) (map[string]ResourceUsage, error) {
+	metrics, err := cli.GetPodRawMetrics(namespace, "", allNamespaces, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]ResourceUsage)
+	for index, metric := range metrics.Items {
+		name := metric.Name
+		if allNamespaces {
+			name = fmt.Sprintf("%s/%s", metric.Namespace, metric.Name)
+		}
+		result[name] = newResourceUsage(GetPodMetrics(&metrics.Items[index]))
+	}
+	return result, nil
+}
+
+// GetPodsRequestsAndLimits returns the sum of requests and limits of running pods.
+func (cli *KubeCLI) GetPodsRequestsAndLimits(namespace string, allNamespaces bool, selector Selector) (*RequestsAndLimits, error) {
+	if selector == nil {
+		selector = Everything()
+	}
+
+	client, err := cli.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	ns := metav1.NamespaceAll
+	if !allNamespaces {
+		ns = namespace
+	}
+	pods, err := client.CoreV1().Pods(ns).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector.String(),
+		FieldSelector: runningPodsFieldSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RequestsAndLimits{
+		Requests: make(ResourceUsage),
+		Limits:   make(ResourceUsage),
+	}
+	for index := range pods.Items {
+		requests, limits := resourcehelper.PodRequestsAndLimits(&pods.Items[index])
+		result.Requests.Add(newResourceUsage(requests))
+		result.Limits.Add(newResourceUsage(limits))
+	}
+	return result, nil
+}
+
+// GetPodContainersRequestsAndLimits returns requests and limits of containers of pod by container name.
+func (cli *KubeCLI) GetPodContainersRequestsAndLimits(namespace, name string) (map[string]*RequestsAndLimits, error) {
+	pod, err := cli.GetPod(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*RequestsAndLimits)
+	for _, container := range pod.Spec.Containers {
+		result[container.Name] = &RequestsAndLimits{
+			Requests: newResourceUsage(container.Resources.Requests),
+			Limits:   newResourceUsage(container.Resources.Limits),
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,59 @@
+package kubecli
+
+import (
+	"bytes"
+	"gopkg.in/yaml.v3"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var (
+	noisyMetadataFields = []string{"managedFields", "resourceVersion", "uid", "selfLink", "generation", "creationTimestamp"}
+)
+
+// GetCleanYAML returns YAML of resource without managedFields, status and other noisy fields.
+func (cli *KubeCLI) GetCleanYAML(resource, name string) (string, error) {
+	obj, err := cli.GetUnstructured(resource, name)
+	if err != nil {
+		return "", err
+	}
+
+	content := obj.UnstructuredContent()
+	delete(content, "status")
+	for _, field := range noisyMetadataFields {
+		unstructured.RemoveNestedField(content, "metadata", field)
+	}
+	unstructured.RemoveNestedField(content, "metadata", "annotations", v1.LastAppliedConfigAnnotation)
+	if len(obj.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(content, "metadata", "annotations")
+	}
+	return ToYAML(content)
+}
+
+// GetStatusYAML returns YAML of resource status.
+func (cli *KubeCLI) GetStatusYAML(resource, name string) (string, error) {
+	obj, err := cli.GetUnstructured(resource, name)
+	if err != nil {
+		return "", err
+	}
+
+	status, ok := obj.UnstructuredContent()["status"]
+	if !ok {
+		return "", nil
+	}
+	return ToYAML(map[string]interface{}{"status": status})
+}
+
+// ToYAML encode obj to YAML with 2 spaces indent, it is shared by Config, Drift and helm values views.
+func ToYAML(obj interface{}) (string, error) {
+	buf := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(obj); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,157 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+type diffLine struct {
+	op   byte
+	text string
+	// Line index of "from" and "to" before this line.
+	fromIndex int
+	toIndex   int
+}
+
+// UnifiedDiff returns the unified format diff of two texts, returns empty string if no differences.
+func UnifiedDiff(fromName, toName, from, to string, context int) string {
+	lines := diffLines(splitLines(from), splitLines(to))
+
+	changed := false
+	for _, line := range lines {
+		if line.op != ' ' {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", fromName, toName))
+
+	start := 0
+	for start < len(lines) {
+		first := start
+		for first < len(lines) && lines[first].op == ' ' {
+			first++
+		}
+		if first >= len(lines) {
+			break
+		}
+
+		// Merge changes which are close to each other into one hunk.
+		last := first
+		for {
+			next := last + 1
+			for next < len(lines) && lines[next].op == ' ' {
+				next++
+			}
+			if next >= len(lines) || next-last-1 > context*2 {
+				break
+			}
+			last = next
+		}
+
+		hunkStart := first - context
+		if hunkStart < start {
+			hunkStart = start
+		}
+		hunkEnd := last + context + 1
+		if hunkEnd > len(lines) {
+			hunkEnd = len(lines)
+		}
+
+		fromCount, toCount := 0, 0
+		for _, line := range lines[hunkStart:hunkEnd] {
+			if line.op != '+' {
+				fromCount++
+			}
+			if line.op != '-' {
+				toCount++
+			}
+		}
+		fromStart, toStart := lines[hunkStart].fromIndex, lines[hunkStart].toIndex
+		if fromCount > 0 {
+			fromStart++
+		}
+		if toCount > 0 {
+			toStart++
+		}
+
+		builder.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", fromStart, fromCount, toStart, toCount))
+		for _, line := range lines[hunkStart:hunkEnd] {
+			builder.WriteString(fmt.Sprintf("%c%s\n", line.op, line.text))
+		}
+		start = hunkEnd
+	}
+	return builder.String()
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimSuffix(s, "\n")
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, "\n")
+}
+
+// diffLines build the edit script of two lines arrays by longest common subsequence.
+// Common prefix and suffix are skipped, so that the table only covers changed lines.
+func diffLines(from, to []string) []diffLine {
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix &&
+		from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]diffLine, 0, len(from)+len(to)-prefix-suffix)
+	for i := 0; i < prefix; i++ {
+		lines = append(lines, diffLine{op: ' ', text: from[i], fromIndex: i, toIndex: i})
+	}
+
+	fromMiddle, toMiddle := from[prefix:len(from)-suffix], to[prefix:len(to)-suffix]
+	n, m := len(fromMiddle), len(toMiddle)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if fromMiddle[i] == toMiddle[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && fromMiddle[i] == toMiddle[j]:
+			lines = append(lines, diffLine{op: ' ', text: fromMiddle[i], fromIndex: prefix + i, toIndex: prefix + j})
+			i++
+			j++
+		case i < n && (j >= m || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{op: '-', text: fromMiddle[i], fromIndex: prefix + i, toIndex: prefix + j})
+			i++
+		default:
+			lines = append(lines, diffLine{op: '+', text: toMiddle[j], fromIndex: prefix + i, toIndex: prefix + j})
+			j++
+		}
+	}
+
+	for k := 0; k < suffix; k++ {
+		fromIndex, toIndex := len(from)-suffix+k, len(to)-suffix+k
+		lines = append(lines, diffLine{op: ' ', text: from[fromIndex], fromIndex: fromIndex, toIndex: toIndex})
+	}
+	return lines
+}
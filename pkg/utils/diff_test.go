@@ -0,0 +1,56 @@
+package utils
+
+import "testing"
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		context int
+		want    string
+	}{
+		{
+			name:    "no differences",
+			from:    "a\nb\n",
+			to:      "a\nb\n",
+			context: 3,
+			want:    "",
+		},
+		{
+			name:    "changed line",
+			from:    "a\nb\nc\n",
+			to:      "a\nB\nc\n",
+			context: 1,
+			want:    "--- from\n+++ to\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n",
+		},
+		{
+			name:    "added to empty",
+			from:    "",
+			to:      "a\n",
+			context: 3,
+			want:    "--- from\n+++ to\n@@ -0,0 +1,1 @@\n+a\n",
+		},
+		{
+			name:    "inserted between common lines",
+			from:    "a\nb\nc\n",
+			to:      "a\nb\nx\nc\n",
+			context: 1,
+			want:    "--- from\n+++ to\n@@ -2,2 +2,3 @@\n b\n+x\n c\n",
+		},
+		{
+			name:    "separated hunks",
+			from:    "1\n2\n3\n4\n5\n6\n7\n8\n",
+			to:      "0\n2\n3\n4\n5\n6\n7\n9\n",
+			context: 1,
+			want:    "--- from\n+++ to\n@@ -1,2 +1,2 @@\n-1\n+0\n 2\n@@ -7,2 +7,2 @@\n 7\n-8\n+9\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnifiedDiff("from", "to", tt.from, tt.to, tt.context); got != tt.want {
+				t.Errorf("UnifiedDiff() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,9 +3,11 @@ package utils
 import (
 	"bytes"
 	"errors"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"os/user"
+	"path/filepath"
 	"runtime"
 	"strings"
 )
@@ -89,3 +91,37 @@ func HomeWindows() (string, error) {
 
 	return home, nil
 }
+
+// CompleteFilePath list files and directories which could complete the inputted path.
+func CompleteFilePath(input string) []string {
+	completions := make([]string, 0)
+	if input == "" {
+		return completions
+	}
+
+	dir, prefix := filepath.Split(input)
+	searchDir := FilePath(dir)
+	if searchDir == "" {
+		searchDir = "."
+	}
+
+	files, err := ioutil.ReadDir(searchDir)
+	if err != nil {
+		return completions
+	}
+
+	for _, file := range files {
+		if !strings.HasPrefix(file.Name(), prefix) {
+			continue
+		}
+		if strings.HasPrefix(file.Name(), ".") && !strings.HasPrefix(prefix, ".") {
+			continue
+		}
+		completion := dir + file.Name()
+		if file.IsDir() {
+			completion += string(filepath.Separator)
+		}
+		completions = append(completions, completion)
+	}
+	return completions
+}
@@ -58,6 +58,8 @@ func GetKey(key interface{}) string {
 		return "esc"
 	case 13:
 		return "enter"
+	case 14:
+		return "Ctrl+n"
 	case 32:
 		return "space"
 	case 65514:
@@ -81,3 +83,31 @@ func GetKey(key interface{}) string {
 	return fmt.Sprintf("%c", k)
 }
 
+// StringInSlice check if string in slice
+func StringInSlice(s string, slice []string) bool {
+	for _, each := range slice {
+		if each == s {
+			return true
+		}
+	}
+	return false
+}
+
+// FuzzyMatch check if all characters of pattern appear in s in order.
+func FuzzyMatch(s, pattern string) bool {
+	runes := []rune(pattern)
+	if len(runes) == 0 {
+		return true
+	}
+
+	index := 0
+	for _, r := range s {
+		if r == runes[index] {
+			index++
+			if index == len(runes) {
+				return true
+			}
+		}
+	}
+	return false
+}